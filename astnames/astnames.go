@@ -0,0 +1,232 @@
+package astnames
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/evolidev/reflectify"
+)
+
+var (
+	ErrNotAFunction   = errors.New("astnames: value is not a function")
+	ErrSourceNotFound = errors.New("astnames: source of function not found")
+	ErrFuncNotFound   = errors.New("astnames: function declaration not found in source")
+	ErrUnnamedParams  = errors.New("astnames: function has unnamed parameters")
+	ErrTypeNotFound   = errors.New("astnames: type declaration not found in source")
+)
+
+func init() {
+	reflectify.RegisterParamNameSource(func(fn reflect.Value) ([]string, bool) {
+		names, err := ParamNamesOf(fn)
+
+		return names, err == nil
+	})
+
+	reflectify.RegisterMethodSource(func(t reflect.Type) ([]reflectify.MethodDeclaration, bool) {
+		declarations, err := MethodsOf(t)
+
+		return declarations, err == nil
+	})
+}
+
+type lookup struct {
+	names []string
+	err   error
+}
+
+var (
+	lookups sync.Map
+	files   sync.Map
+)
+
+func ParamNames(fn any) ([]string, error) {
+	return ParamNamesOf(reflect.ValueOf(fn))
+}
+
+func ParamNamesOf(fn reflect.Value) ([]string, error) {
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return nil, ErrNotAFunction
+	}
+
+	pc := fn.Pointer()
+	if cached, ok := lookups.Load(pc); ok {
+		return cached.(lookup).names, cached.(lookup).err
+	}
+
+	names, err := extract(pc, fn.Type())
+	lookups.Store(pc, lookup{names: names, err: err})
+
+	return names, err
+}
+
+func extract(pc uintptr, t reflect.Type) ([]string, error) {
+	f := runtime.FuncForPC(pc)
+	if f == nil {
+		return nil, ErrSourceNotFound
+	}
+
+	filename, line := f.FileLine(pc)
+	if !strings.HasSuffix(filename, ".go") {
+		return nil, ErrSourceNotFound
+	}
+
+	fset, file, err := parse(filename)
+	if err != nil {
+		return nil, ErrSourceNotFound
+	}
+
+	funcType := findFuncType(fset, file, line, t, closureDepth(f.Name()))
+	if funcType == nil {
+		return nil, ErrFuncNotFound
+	}
+
+	return paramNames(funcType)
+}
+
+type parsedFile struct {
+	fset *token.FileSet
+	file *ast.File
+	err  error
+}
+
+func parse(filename string) (*token.FileSet, *ast.File, error) {
+	if cached, ok := files.Load(filename); ok {
+		parsed := cached.(parsedFile)
+
+		return parsed.fset, parsed.file, parsed.err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+	files.Store(filename, parsedFile{fset: fset, file: file, err: err})
+
+	return fset, file, err
+}
+
+func findFuncType(fset *token.FileSet, file *ast.File, line int, t reflect.Type, depth int) *ast.FuncType {
+	var found *ast.FuncType
+	candidates := 0
+	literals := 0
+	stack := make([]bool, 0)
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if node == nil {
+			if stack[len(stack)-1] {
+				literals--
+			}
+			stack = stack[:len(stack)-1]
+
+			return true
+		}
+
+		if fset.Position(node.Pos()).Line > line || fset.Position(node.End()).Line < line {
+			return false
+		}
+
+		_, isLiteral := node.(*ast.FuncLit)
+
+		var funcType *ast.FuncType
+		receivers := 0
+		nodeDepth := 0
+
+		switch fn := node.(type) {
+		case *ast.FuncDecl:
+			funcType = fn.Type
+			if fn.Recv != nil {
+				receivers = 1
+			}
+		case *ast.FuncLit:
+			funcType = fn.Type
+			nodeDepth = literals + 1
+		}
+
+		if funcType != nil && nodeDepth == depth && countParams(funcType)+receivers == t.NumIn() {
+			found = funcType
+			candidates++
+		}
+
+		if isLiteral {
+			literals++
+		}
+		stack = append(stack, isLiteral)
+
+		return true
+	})
+
+	if candidates != 1 {
+		return nil
+	}
+
+	return found
+}
+
+func closureDepth(name string) int {
+	name = strings.TrimSuffix(name, "-fm")
+	segments := strings.Split(name, ".")
+
+	depth := 0
+	for i := len(segments) - 1; i > 0; i-- {
+		if !isClosureSegment(segments[i]) {
+			break
+		}
+
+		depth++
+	}
+
+	return depth
+}
+
+func isClosureSegment(segment string) bool {
+	segment = strings.TrimPrefix(segment, "func")
+	if segment == "" {
+		return false
+	}
+
+	for _, c := range segment {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func countParams(funcType *ast.FuncType) int {
+	count := 0
+	for _, field := range funcType.Params.List {
+		if len(field.Names) == 0 {
+			count++
+			continue
+		}
+
+		count += len(field.Names)
+	}
+
+	return count
+}
+
+func paramNames(funcType *ast.FuncType) ([]string, error) {
+	names := make([]string, 0, countParams(funcType))
+
+	for _, field := range funcType.Params.List {
+		if len(field.Names) == 0 {
+			return nil, ErrUnnamedParams
+		}
+
+		for _, name := range field.Names {
+			if name.Name == "_" {
+				return nil, ErrUnnamedParams
+			}
+
+			names = append(names, name.Name)
+		}
+	}
+
+	return names, nil
+}
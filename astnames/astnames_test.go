@@ -0,0 +1,235 @@
+package astnames
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/evolidev/reflectify"
+)
+
+func TestParamNames(t *testing.T) {
+	t.Run("param names of function should be extracted", func(t *testing.T) {
+		names, err := ParamNames(testHandler)
+
+		expected := []string{"id", "name", "active"}
+		if err != nil || !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v. error: %v", names, expected, err)
+		}
+	})
+
+	t.Run("param names of method should exclude the receiver", func(t *testing.T) {
+		names, err := ParamNames(testController.Show)
+
+		expected := []string{"id"}
+		if err != nil || !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v. error: %v", names, expected, err)
+		}
+	})
+
+	t.Run("param names of pointer method should be extracted", func(t *testing.T) {
+		names, err := ParamNames((*testController).Update)
+
+		expected := []string{"id", "payload"}
+		if err != nil || !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v. error: %v", names, expected, err)
+		}
+	})
+
+	t.Run("param names of function literal should be extracted", func(t *testing.T) {
+		fn := func(page int, size int) {}
+
+		names, err := ParamNames(fn)
+
+		expected := []string{"page", "size"}
+		if err != nil || !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v. error: %v", names, expected, err)
+		}
+	})
+
+	t.Run("param names of nested function literals on one line should be extracted", func(t *testing.T) {
+		var inner func(b int) int
+		outer := func(a int) int { inner = func(b int) int { return b }; return inner(a) }
+		outer(1)
+
+		names, err := ParamNames(outer)
+
+		expected := []string{"a"}
+		if err != nil || !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v. error: %v", names, expected, err)
+		}
+
+		names, err = ParamNames(inner)
+
+		expected = []string{"b"}
+		if err != nil || !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v. error: %v", names, expected, err)
+		}
+	})
+
+	t.Run("ambiguous function literals should return error", func(t *testing.T) {
+		first, second := func(a int) {}, func(b int) {}
+
+		_, err := ParamNames(first)
+		if !errors.Is(err, ErrFuncNotFound) {
+			t.Errorf("expected func not found error. %v given", err)
+		}
+
+		_, err = ParamNames(second)
+		if !errors.Is(err, ErrFuncNotFound) {
+			t.Errorf("expected func not found error. %v given", err)
+		}
+	})
+
+	t.Run("unnamed params should return error", func(t *testing.T) {
+		fn := func(int, string) {}
+
+		_, err := ParamNames(fn)
+
+		if !errors.Is(err, ErrUnnamedParams) {
+			t.Errorf("expected unnamed params error. %v given", err)
+		}
+	})
+
+	t.Run("missing source should return error", func(t *testing.T) {
+		fn := reflect.ValueOf(testController{}).MethodByName("Show")
+
+		_, err := ParamNamesOf(fn)
+
+		if !errors.Is(err, ErrSourceNotFound) {
+			t.Errorf("expected source not found error. %v given", err)
+		}
+	})
+
+	t.Run("none function should return error", func(t *testing.T) {
+		_, err := ParamNames("test")
+
+		if !errors.Is(err, ErrNotAFunction) {
+			t.Errorf("expected not a function error. %v given", err)
+		}
+	})
+}
+
+func TestReflectionIntegration(t *testing.T) {
+	t.Run("params should expose extracted names", func(t *testing.T) {
+		params := reflectify.Reflect(testHandler).Params()
+
+		names := make([]string, len(params))
+		for i, param := range params {
+			names[i] = param.ParamName()
+		}
+
+		expected := []string{"id", "name", "active"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v", names, expected)
+		}
+	})
+
+	t.Run("call named should work without explicit names", func(t *testing.T) {
+		result, err := reflectify.Reflect(testHandler).CallNamed(map[string]any{
+			"id":     "5",
+			"name":   "john",
+			"active": "true",
+		})
+
+		if err != nil || result[0].String() != "5-john-true" {
+			t.Errorf("func not called correctly. error: %v", err)
+		}
+	})
+
+	t.Run("explicit names should take precedence", func(t *testing.T) {
+		refl := reflectify.Reflect(testHandler).WithParamNames("a", "b", "c")
+
+		if names := refl.ParamNames(); names[0] != "a" {
+			t.Errorf("explicit names should be used. %v given", names)
+		}
+	})
+}
+
+func testHandler(id int, name string, active bool) string {
+	parts := []string{reflectify.NewMapper(id).String(), name, reflectify.NewMapper(active).String()}
+
+	return strings.Join(parts, "-")
+}
+
+type testController struct{}
+
+func (c testController) Show(id int) string {
+	return "show"
+}
+
+func (c *testController) Update(id int, payload map[string]any) {}
+
+func (c testController) render(name string) string {
+	return name
+}
+
+type testModel struct{}
+
+func (m testModel) validate() error {
+	return nil
+}
+
+type testShape struct{}
+
+func (s testShape) scale(x, y float64, labels ...string) (w, h float64) {
+	return x, y
+}
+
+func TestMethods(t *testing.T) {
+	t.Run("methods should contain unexported methods", func(t *testing.T) {
+		declarations, err := Methods(testController{})
+
+		if err != nil || len(declarations) != 3 {
+			t.Fatalf("current len of %d does not match expected %d. error: %v", len(declarations), 3, err)
+		}
+
+		render := declarations[2]
+		if render.Name != "render" || render.IsExported() || render.Signature != "func(string) string" {
+			t.Errorf("render should be reported as unexported. %+v given", render)
+		}
+		if !declarations[1].PointerReceiver {
+			t.Errorf("Update should be reported with pointer receiver")
+		}
+	})
+
+	t.Run("methods of types without exported methods should be found", func(t *testing.T) {
+		declarations, err := Methods(&testModel{})
+
+		if err != nil || len(declarations) != 1 || declarations[0].Name != "validate" {
+			t.Errorf("validate should be found. %+v given. error: %v", declarations, err)
+		}
+	})
+
+	t.Run("method signatures should match the notation of reflect", func(t *testing.T) {
+		declarations, err := Methods(testShape{})
+
+		expected := "func(float64, float64, ...string) (float64, float64)"
+		if err != nil || len(declarations) != 1 || declarations[0].Signature != expected {
+			t.Errorf("signature %s expected. %+v given. error: %v", expected, declarations, err)
+		}
+	})
+
+	t.Run("methods of unnamed types should fail", func(t *testing.T) {
+		_, err := Methods(struct{}{})
+
+		if !errors.Is(err, ErrTypeNotFound) {
+			t.Errorf("expected type not found error. %v given", err)
+		}
+	})
+
+	t.Run("method declarations of reflections should contain unexported methods", func(t *testing.T) {
+		declarations := reflectify.Reflect(testController{}).MethodDeclarations(reflectify.IncludeUnexported)
+
+		names := make([]string, 0)
+		for _, declaration := range declarations {
+			names = append(names, declaration.Name)
+		}
+
+		expected := []string{"Show", "render"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v", names, expected)
+		}
+	})
+}
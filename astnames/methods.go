@@ -0,0 +1,199 @@
+package astnames
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/evolidev/reflectify"
+)
+
+type methodLookup struct {
+	declarations []reflectify.MethodDeclaration
+	err          error
+}
+
+var methodLookups sync.Map
+
+func Methods(v any) ([]reflectify.MethodDeclaration, error) {
+	return MethodsOf(reflect.TypeOf(v))
+}
+
+func MethodsOf(t reflect.Type) ([]reflectify.MethodDeclaration, error) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Name() == "" || t.PkgPath() == "" {
+		return nil, ErrTypeNotFound
+	}
+
+	if cached, ok := methodLookups.Load(t); ok {
+		return cached.(methodLookup).declarations, cached.(methodLookup).err
+	}
+
+	declarations, err := extractMethods(t)
+	methodLookups.Store(t, methodLookup{declarations: declarations, err: err})
+
+	return declarations, err
+}
+
+func extractMethods(t reflect.Type) ([]reflectify.MethodDeclaration, error) {
+	dir, err := packageDir(t)
+	if err != nil {
+		return nil, err
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil || len(filenames) == 0 {
+		return nil, ErrSourceNotFound
+	}
+
+	typeName := t.Name()
+	if i := strings.IndexByte(typeName, '['); i >= 0 {
+		typeName = typeName[:i]
+	}
+
+	externalTest := strings.HasSuffix(t.PkgPath(), "_test")
+	declarations := make([]reflectify.MethodDeclaration, 0)
+	found := false
+
+	for _, filename := range filenames {
+		fset, file, err := parse(filename)
+		if err != nil || strings.HasSuffix(file.Name.Name, "_test") != externalTest {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			if declaresType(decl, typeName) {
+				found = true
+			}
+
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+				continue
+			}
+
+			name, pointer := receiverType(fn.Recv.List[0].Type)
+			if name != typeName {
+				continue
+			}
+
+			declarations = append(declarations, reflectify.MethodDeclaration{
+				Name:            fn.Name.Name,
+				PkgPath:         t.PkgPath(),
+				Signature:       render(fset, signature(fn.Type)),
+				PointerReceiver: pointer,
+			})
+		}
+	}
+
+	if !found {
+		return nil, ErrTypeNotFound
+	}
+
+	sort.Slice(declarations, func(i, j int) bool {
+		return declarations[i].Name < declarations[j].Name
+	})
+
+	return declarations, nil
+}
+
+func packageDir(t reflect.Type) (string, error) {
+	for _, candidate := range []reflect.Type{t, reflect.PointerTo(t)} {
+		for i := 0; i < candidate.NumMethod(); i++ {
+			pc := candidate.Method(i).Func.Pointer()
+
+			f := runtime.FuncForPC(pc)
+			if f == nil {
+				continue
+			}
+
+			if filename, _ := f.FileLine(pc); strings.HasSuffix(filename, ".go") {
+				return filepath.Dir(filename), nil
+			}
+		}
+	}
+
+	wd, _ := os.Getwd()
+
+	pkg, err := build.Import(strings.TrimSuffix(t.PkgPath(), "_test"), wd, build.FindOnly)
+	if err != nil {
+		return "", ErrSourceNotFound
+	}
+
+	return pkg.Dir, nil
+}
+
+func declaresType(decl ast.Decl, name string) bool {
+	gen, ok := decl.(*ast.GenDecl)
+	if !ok || gen.Tok != token.TYPE {
+		return false
+	}
+
+	for _, spec := range gen.Specs {
+		if spec, ok := spec.(*ast.TypeSpec); ok && spec.Name.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func receiverType(expr ast.Expr) (string, bool) {
+	pointer := false
+	if star, ok := expr.(*ast.StarExpr); ok {
+		pointer = true
+		expr = star.X
+	}
+
+	switch receiver := expr.(type) {
+	case *ast.IndexExpr:
+		expr = receiver.X
+	case *ast.IndexListExpr:
+		expr = receiver.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name, pointer
+	}
+
+	return "", pointer
+}
+
+func signature(fn *ast.FuncType) *ast.FuncType {
+	return &ast.FuncType{Func: fn.Func, Params: unnamed(fn.Params), Results: unnamed(fn.Results)}
+}
+
+func unnamed(fields *ast.FieldList) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+
+	result := &ast.FieldList{Opening: fields.Opening, Closing: fields.Closing}
+	for _, field := range fields.List {
+		for i := 0; i < len(field.Names) || i == 0; i++ {
+			result.List = append(result.List, &ast.Field{Type: field.Type})
+		}
+	}
+
+	return result
+}
+
+func render(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, node); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,37 @@
+package reflectify
+
+import (
+	"reflect"
+)
+
+func To[T any](v any) (T, error) {
+	var result T
+
+	if typed, ok := v.(T); ok {
+		return typed, nil
+	}
+
+	converted, err := convertTo(v, reflect.TypeOf(&result).Elem())
+	if err != nil {
+		return result, err
+	}
+
+	if converted != nil {
+		reflect.ValueOf(&result).Elem().Set(reflect.ValueOf(converted))
+	}
+
+	return result, nil
+}
+
+func MustTo[T any](v any) T {
+	result, err := To[T](v)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+func convertTo(v any, t reflect.Type) (any, error) {
+	return NewMapper(v).Convert(t)
+}
@@ -0,0 +1,112 @@
+package reflectify
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTo(t *testing.T) {
+	one := 1
+	tests := []struct {
+		name     string
+		convert  func() (any, error)
+		expected any
+	}{
+		{"int from string", func() (any, error) { return To[int]("1") }, 1},
+		{"int8 from float", func() (any, error) { return To[int8](2.0) }, int8(2)},
+		{"int16 from bool", func() (any, error) { return To[int16](true) }, int16(1)},
+		{"int32 from hex string", func() (any, error) { return To[int32]("0x10") }, int32(16)},
+		{"int64 from uint", func() (any, error) { return To[int64](uint(7)) }, int64(7)},
+		{"uint from int", func() (any, error) { return To[uint](7) }, uint(7)},
+		{"uint8 from string", func() (any, error) { return To[uint8]("255") }, uint8(255)},
+		{"uint16 from int64", func() (any, error) { return To[uint16](int64(9)) }, uint16(9)},
+		{"uint32 from float32", func() (any, error) { return To[uint32](float32(3)) }, uint32(3)},
+		{"uint64 from string", func() (any, error) { return To[uint64]("1_000") }, uint64(1000)},
+		{"float32 from string", func() (any, error) { return To[float32]("1.5") }, float32(1.5)},
+		{"float64 from int", func() (any, error) { return To[float64](3) }, 3.0},
+		{"complex64 from int", func() (any, error) { return To[complex64](3) }, complex64(3)},
+		{"complex128 from string", func() (any, error) { return To[complex128]("1+1i") }, complex(1, 1)},
+		{"bool from string", func() (any, error) { return To[bool]("true") }, true},
+		{"string from int", func() (any, error) { return To[string](12) }, "12"},
+		{"string from bytes", func() (any, error) { return To[string]([]byte("ab")) }, "ab"},
+		{"named type from string", func() (any, error) { return To[testLevel]("2") }, testLevel(2)},
+		{"pointer from int", func() (any, error) { return To[*int]("1") }, &one},
+		{"interface from value", func() (any, error) { return To[any]("1") }, "1"},
+		{"zero value from nil", func() (any, error) { return To[int](nil) }, 0},
+		{"struct from map", func() (any, error) {
+			return To[TestStruct](map[string]any{"field1": "test"})
+		}, TestStruct{Field1: "test"}},
+		{"struct pointer from map", func() (any, error) {
+			return To[*TestStruct](map[string]any{"field1": "test"})
+		}, &TestStruct{Field1: "test"}},
+		{"map from map", func() (any, error) {
+			return To[map[string]int](map[string]any{"a": "1"})
+		}, map[string]int{"a": 1}},
+		{"slice from slice", func() (any, error) {
+			return To[[]int]([]any{1, "2"})
+		}, []int{1, 2}},
+		{"array from slice", func() (any, error) {
+			return To[[2]string]([]any{1, "2"})
+		}, [2]string{"1", "2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.convert()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("converted value %#v does not match expected %#v", result, test.expected)
+			}
+		})
+	}
+}
+
+func TestToWithError(t *testing.T) {
+	tests := []struct {
+		name    string
+		convert func() (any, error)
+	}{
+		{"int from none numeric string", func() (any, error) { return To[int]("test") }},
+		{"int8 from overflowing int", func() (any, error) { return To[int8](300) }},
+		{"string from struct", func() (any, error) { return To[string](TestStruct{}) }},
+		{"struct from string", func() (any, error) { return To[TestStruct]("test") }},
+		{"error from string", func() (any, error) { return To[error]("test") }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.convert()
+
+			var convErr *ConversionError
+			if !errors.As(err, &convErr) {
+				t.Errorf("expected conversion error. %v given", err)
+			}
+		})
+	}
+}
+
+func TestMustTo(t *testing.T) {
+	t.Run("must to should return converted value", func(t *testing.T) {
+		result := MustTo[int]("5")
+
+		if result != 5 {
+			t.Errorf("current value '%d' given. expected: %d", result, 5)
+		}
+	})
+
+	t.Run("must to should panic on conversion error", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("must to should panic")
+			}
+		}()
+
+		MustTo[int]("test")
+	})
+}
+
+type testLevel int
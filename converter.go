@@ -0,0 +1,195 @@
+package reflectify
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+type Converter func(value any) (any, error)
+
+var globalConverters = newConverterRegistry()
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+func RegisterConverter(from, to reflect.Type, fn Converter) {
+	globalConverters.register(from, to, fn)
+}
+
+type converterRegistry struct {
+	mu         sync.RWMutex
+	converters map[reflect.Type]map[reflect.Type]Converter
+}
+
+func newConverterRegistry() *converterRegistry {
+	return &converterRegistry{converters: make(map[reflect.Type]map[reflect.Type]Converter)}
+}
+
+func (c *converterRegistry) register(from, to reflect.Type, fn Converter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.converters[from]; !ok {
+		c.converters[from] = make(map[reflect.Type]Converter)
+	}
+
+	c.converters[from][to] = fn
+}
+
+func (c *converterRegistry) empty() bool {
+	if c == nil {
+		return true
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.converters) == 0
+}
+
+func (c *converterRegistry) lookup(from, to reflect.Type) (Converter, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	fn, ok := c.converters[from][to]
+
+	return fn, ok
+}
+
+func (c *converterRegistry) each(from reflect.Type, visit func(to reflect.Type, fn Converter)) {
+	if c == nil {
+		return
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for to, fn := range c.converters[from] {
+		visit(to, fn)
+	}
+}
+
+func converterFor(from, to reflect.Type, local *converterRegistry) (Converter, bool) {
+	if fn, ok := local.lookup(from, to); ok {
+		return fn, true
+	}
+
+	return globalConverters.lookup(from, to)
+}
+
+func lookupConverter(from, to reflect.Type, local *converterRegistry) (Converter, bool) {
+	if globalConverters.empty() && local.empty() {
+		return nil, false
+	}
+
+	type step struct {
+		t     reflect.Type
+		chain []Converter
+	}
+
+	var visited map[reflect.Type]bool
+	var queue []step
+
+	enqueue := func(current step, target reflect.Type, fn Converter) {
+		if target == from || visited[target] {
+			return
+		}
+
+		if visited == nil {
+			visited = make(map[reflect.Type]bool)
+		}
+
+		visited[target] = true
+		chain := make([]Converter, len(current.chain), len(current.chain)+1)
+		copy(chain, current.chain)
+		queue = append(queue, step{t: target, chain: append(chain, fn)})
+	}
+
+	current := step{t: from}
+	for {
+		if fn, ok := converterFor(current.t, to, local); ok {
+			return chainConverters(append(current.chain, fn)), true
+		}
+
+		local.each(current.t, func(target reflect.Type, fn Converter) {
+			enqueue(current, target, fn)
+		})
+		globalConverters.each(current.t, func(target reflect.Type, fn Converter) {
+			enqueue(current, target, fn)
+		})
+
+		if len(queue) == 0 {
+			return nil, false
+		}
+
+		current, queue = queue[0], queue[1:]
+	}
+}
+
+func chainConverters(chain []Converter) Converter {
+	if len(chain) == 1 {
+		return chain[0]
+	}
+
+	return func(value any) (any, error) {
+		var err error
+		for _, fn := range chain {
+			value, err = fn(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return value, nil
+	}
+}
+
+func unmarshalText(value any, to reflect.Type) (any, bool, error) {
+	var text []byte
+	switch v := reflect.ValueOf(value); {
+	case v.Kind() == reflect.String:
+		text = []byte(v.String())
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		text = v.Bytes()
+	default:
+		return nil, false, nil
+	}
+
+	if reflect.PointerTo(to).Implements(textUnmarshalerType) {
+		destination := reflect.New(to)
+		if err := destination.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+			return nil, true, err
+		}
+
+		return destination.Elem().Interface(), true, nil
+	}
+
+	if to.Kind() == reflect.Ptr && to.Implements(textUnmarshalerType) {
+		destination := reflect.New(to.Elem())
+		if err := destination.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+			return nil, true, err
+		}
+
+		return destination.Interface(), true, nil
+	}
+
+	return nil, false, nil
+}
+
+func stringify(value any, to reflect.Type) (any, bool) {
+	if to.Kind() != reflect.String {
+		return nil, false
+	}
+
+	stringer, ok := value.(fmt.Stringer)
+	if !ok {
+		return nil, false
+	}
+
+	return reflect.ValueOf(stringer.String()).Convert(to).Interface(), true
+}
@@ -0,0 +1,247 @@
+package reflectify
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegisterConverter(t *testing.T) {
+	RegisterConverter(reflect.TypeOf(""), reflect.TypeOf(testID{}), func(value any) (any, error) {
+		return testID{value: value.(string)}, nil
+	})
+	RegisterConverter(reflect.TypeOf(testID{}), reflect.TypeOf(testAccount{}), func(value any) (any, error) {
+		return testAccount{id: value.(testID)}, nil
+	})
+
+	t.Run("mapper should use registered converter", func(t *testing.T) {
+		result, err := NewMapper("abc").Convert(reflect.TypeOf(testID{}))
+
+		if err != nil || result.(testID).value != "abc" {
+			t.Errorf("could not convert string to registered type. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("mapper should chain registered converters", func(t *testing.T) {
+		result, err := NewMapper("abc").Convert(reflect.TypeOf(testAccount{}))
+
+		if err != nil || result.(testAccount).id.value != "abc" {
+			t.Errorf("could not convert string through converter chain. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("call should use registered converter", func(t *testing.T) {
+		var tmp testID
+		refl := Reflect(func(id testID) { tmp = id })
+
+		refl.Call("abc")
+
+		if tmp.value != "abc" {
+			t.Errorf("current value '%s' given. expected: %s", tmp.value, "abc")
+		}
+	})
+
+	t.Run("reflection converter should override global converter", func(t *testing.T) {
+		var tmp testID
+		refl := Reflect(func(id testID) { tmp = id })
+		refl.RegisterConverter(reflect.TypeOf(""), reflect.TypeOf(testID{}), func(value any) (any, error) {
+			return testID{value: strings.ToUpper(value.(string))}, nil
+		})
+
+		refl.Call("abc")
+
+		if tmp.value != "ABC" {
+			t.Errorf("current value '%s' given. expected: %s", tmp.value, "ABC")
+		}
+	})
+
+	t.Run("reflection converter should not leak to other reflections", func(t *testing.T) {
+		var tmp testID
+		Reflect(func(id testID) {}).RegisterConverter(reflect.TypeOf(""), reflect.TypeOf(testID{}), func(value any) (any, error) {
+			return testID{value: "leaked"}, nil
+		})
+		refl := Reflect(func(id testID) { tmp = id })
+
+		refl.Call("abc")
+
+		if tmp.value != "abc" {
+			t.Errorf("current value '%s' given. expected: %s", tmp.value, "abc")
+		}
+	})
+
+	t.Run("converter lookup should not allocate without a first converter", func(t *testing.T) {
+		local := newConverterRegistry()
+
+		allocs := testing.AllocsPerRun(100, func() {
+			lookupConverter(reflect.TypeOf(0), reflect.TypeOf(testID{}), local)
+			lookupConverter(reflect.TypeOf(0), reflect.TypeOf(testID{}), nil)
+		})
+
+		if allocs != 0 {
+			t.Errorf("lookup should not allocate. %v allocations given", allocs)
+		}
+	})
+
+	t.Run("reflection converter should be used inside converter chains", func(t *testing.T) {
+		var tmp testAccount
+		refl := Reflect(func(account testAccount) { tmp = account })
+		refl.RegisterConverter(reflect.TypeOf(""), reflect.TypeOf(testID{}), func(value any) (any, error) {
+			return testID{value: strings.ToUpper(value.(string))}, nil
+		})
+
+		refl.Call("abc")
+
+		if tmp.id.value != "ABC" {
+			t.Errorf("current value '%s' given. expected: %s", tmp.id.value, "ABC")
+		}
+	})
+
+	t.Run("typed mapper conversions should use registered converter", func(t *testing.T) {
+		RegisterConverter(reflect.TypeOf(testVersion{}), reflect.TypeOf(0), func(value any) (any, error) {
+			return value.(testVersion).major, nil
+		})
+
+		result, err := NewMapper(testVersion{major: 42}).IntE()
+
+		if err != nil || result != 42 {
+			t.Errorf("could not convert registered type to int. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("converter results of the wrong type should be returned as conversion error", func(t *testing.T) {
+		RegisterConverter(reflect.TypeOf(testRevision{}), reflect.TypeOf(""), func(value any) (any, error) {
+			return 1, nil
+		})
+
+		_, err := To[string](testRevision{})
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("expected conversion error for mismatching converter result. %v given", err)
+		}
+	})
+
+	t.Run("call e should report converter results of the wrong type", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(score float64) { called = true })
+		refl.RegisterConverter(reflect.TypeOf(testRevision{}), reflect.TypeOf(float64(0)), func(value any) (any, error) {
+			return "high", nil
+		})
+
+		_, err := refl.CallE(testRevision{})
+
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) || !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("expected argument error for mismatching converter result. %v given", err)
+		}
+		if called {
+			t.Errorf("func should not be called with a mismatching converter result")
+		}
+	})
+
+	t.Run("converter errors should be returned as conversion error", func(t *testing.T) {
+		failed := errors.New("failed")
+		refl := Reflect(func(id testID) {})
+		refl.RegisterConverter(reflect.TypeOf(0), reflect.TypeOf(testID{}), func(value any) (any, error) {
+			return nil, failed
+		})
+		refl.SetStrict(true)
+
+		_, err := refl.CallE(1)
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || !errors.Is(err, failed) {
+			t.Errorf("expected conversion error wrapping converter error. %v given", err)
+		}
+	})
+}
+
+func TestAutomaticConverters(t *testing.T) {
+	t.Run("text unmarshaler should be used for strings", func(t *testing.T) {
+		result, err := To[testColor]("red")
+
+		if err != nil || result != testColorRed {
+			t.Errorf("could not unmarshal text. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("text unmarshaler should be used for pointers", func(t *testing.T) {
+		result, err := To[*testColor]([]byte("red"))
+
+		if err != nil || *result != testColorRed {
+			t.Errorf("could not unmarshal text. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("text unmarshaler errors should be returned", func(t *testing.T) {
+		_, err := To[testColor]("blue")
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("expected conversion error. %v given", err)
+		}
+	})
+
+	t.Run("stringer should be used for strings", func(t *testing.T) {
+		result, err := To[string](testColorRed)
+
+		if err != nil || result != "red" {
+			t.Errorf("could not stringify value. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("typed mapper conversions should use stringer", func(t *testing.T) {
+		if result := NewMapper(time.Second).String(); result != "1s" {
+			t.Errorf("current value '%s' given. expected: %s", result, "1s")
+		}
+	})
+
+	t.Run("call should use text unmarshaler", func(t *testing.T) {
+		var tmp testColor
+		refl := Reflect(func(color testColor) { tmp = color })
+
+		refl.Call("red")
+
+		if tmp != testColorRed {
+			t.Errorf("current value '%v' given. expected: %v", tmp, testColorRed)
+		}
+	})
+}
+
+type testID struct {
+	value string
+}
+
+type testVersion struct {
+	major int
+}
+
+type testRevision struct{}
+
+type testAccount struct {
+	id testID
+}
+
+type testColor int
+
+const testColorRed testColor = 1
+
+func (c testColor) String() string {
+	if c == testColorRed {
+		return "red"
+	}
+
+	return "unknown"
+}
+
+func (c *testColor) UnmarshalText(text []byte) error {
+	if string(text) != "red" {
+		return errors.New("unknown color")
+	}
+
+	*c = testColorRed
+
+	return nil
+}
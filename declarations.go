@@ -0,0 +1,109 @@
+package reflectify
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+type MethodDeclaration struct {
+	Name            string
+	PkgPath         string
+	Signature       string
+	Type            reflect.Type
+	PointerReceiver bool
+}
+
+func (d MethodDeclaration) IsExported() bool {
+	first, _ := utf8.DecodeRuneInString(d.Name)
+
+	return unicode.IsUpper(first)
+}
+
+type MethodSource func(t reflect.Type) ([]MethodDeclaration, bool)
+
+var methodSources struct {
+	sync.RWMutex
+	sources []MethodSource
+}
+
+func RegisterMethodSource(source MethodSource) {
+	methodSources.Lock()
+	defer methodSources.Unlock()
+
+	methodSources.sources = append(methodSources.sources, source)
+}
+
+func (r *Reflection) MethodDeclarations(options ...MemberOption) []MethodDeclaration {
+	option := combineMemberOptions(options)
+	base := elemType(r.t)
+
+	result := make([]MethodDeclaration, 0)
+	known := make(map[string]bool)
+
+	for _, method := range r.methodSet(option) {
+		origin := methodOrigin(base, method.Name)
+		if option.has(DeclaredOnly) && len(origin.index) > 0 {
+			continue
+		}
+
+		_, valueReceiver := origin.declaredBy.MethodByName(method.Name)
+
+		signature := method.Type
+		if r.t.Kind() != reflect.Interface {
+			signature = methodSignature(method.Type)
+		}
+
+		result = append(result, MethodDeclaration{
+			Name:            method.Name,
+			PkgPath:         origin.declaredBy.PkgPath(),
+			Signature:       signature.String(),
+			Type:            method.Type,
+			PointerReceiver: !valueReceiver && origin.declaredBy.Kind() != reflect.Interface,
+		})
+		known[method.Name] = true
+	}
+
+	if option.has(IncludeUnexported) {
+		for _, declaration := range sourceMethodDeclarations(base) {
+			if !declaration.IsExported() && !known[declaration.Name] {
+				result = append(result, declaration)
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func sourceMethodDeclarations(t reflect.Type) []MethodDeclaration {
+	methodSources.RLock()
+	defer methodSources.RUnlock()
+
+	for _, source := range methodSources.sources {
+		if declarations, ok := source(t); ok {
+			return declarations
+		}
+	}
+
+	return nil
+}
+
+func methodSignature(t reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, t.NumIn())
+	for i := 1; i < t.NumIn(); i++ {
+		in = append(in, t.In(i))
+	}
+
+	out := make([]reflect.Type, 0, t.NumOut())
+	for i := 0; i < t.NumOut(); i++ {
+		out = append(out, t.Out(i))
+	}
+
+	return reflect.FuncOf(in, out, t.IsVariadic())
+}
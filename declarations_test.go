@@ -0,0 +1,90 @@
+package reflectify
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMethodDeclarations(t *testing.T) {
+	t.Run("declarations should describe exported methods", func(t *testing.T) {
+		declarations := Reflect(TestStruct{}).MethodDeclarations()
+
+		names := make([]string, 0)
+		for _, declaration := range declarations {
+			names = append(names, declaration.Name)
+		}
+
+		expected := []string{"Test", "TestWithMultiParam", "TestWithScalarParam"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("names %v given. expected: %v", names, expected)
+		}
+
+		declaration := declarations[2]
+		if declaration.Signature != "func(string) string" {
+			t.Errorf("signature '%s' given. expected: '%s'", declaration.Signature, "func(string) string")
+		}
+		if declaration.PkgPath != "github.com/evolidev/reflectify" || !declaration.IsExported() || declaration.PointerReceiver {
+			t.Errorf("declaration %+v does not describe an exported value receiver method", declaration)
+		}
+		if declaration.Type == nil || declaration.Type.In(0) != reflect.TypeOf(TestStruct{}) {
+			t.Errorf("type should contain the receiver")
+		}
+	})
+
+	t.Run("declarations should report pointer receivers", func(t *testing.T) {
+		declarations := Reflect(TestStruct{}).MethodDeclarations(PointerMethods)
+
+		if len(declarations) != 1 || !declarations[0].PointerReceiver {
+			t.Errorf("pointer receiver method should be reported")
+		}
+	})
+
+	t.Run("declarations of interfaces should keep the signature", func(t *testing.T) {
+		declarations := Reflect(new(error)).MethodDeclarations(ValueMethods)
+
+		if len(declarations) != 1 || declarations[0].Signature != "func() string" {
+			t.Errorf("error interface should declare Error. %+v given", declarations)
+		}
+	})
+
+	t.Run("declarations should not contain unexported methods without a source", func(t *testing.T) {
+		declarations := Reflect(TestStruct{}).MethodDeclarations(IncludeUnexported)
+
+		for _, declaration := range declarations {
+			if !declaration.IsExported() {
+				t.Errorf("unexported method %s should not be known", declaration.Name)
+			}
+		}
+	})
+
+	t.Run("declarations should contain unexported methods of registered sources", func(t *testing.T) {
+		RegisterMethodSource(func(t reflect.Type) ([]MethodDeclaration, bool) {
+			if t != reflect.TypeOf(testHidden{}) {
+				return nil, false
+			}
+
+			return []MethodDeclaration{
+				{Name: "Visible", Signature: "func()"},
+				{Name: "hidden", Signature: "func() int", PkgPath: t.PkgPath()},
+			}, true
+		})
+
+		declarations := Reflect(&testHidden{}).MethodDeclarations(IncludeUnexported)
+
+		if len(declarations) != 2 || declarations[1].Name != "hidden" || declarations[1].IsExported() {
+			t.Errorf("hidden should be reported as unexported. %+v given", declarations)
+		}
+
+		if len(Reflect(testHidden{}).MethodDeclarations()) != 1 {
+			t.Errorf("unexported methods should only be listed on request")
+		}
+	})
+}
+
+type testHidden struct{}
+
+func (testHidden) Visible() {}
+
+func (testHidden) hidden() int {
+	return 1
+}
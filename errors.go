@@ -0,0 +1,170 @@
+package reflectify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	ErrNotCallable           = errors.New("reflectify: reflected element is not a function")
+	ErrOverflow              = errors.New("reflectify: value out of range")
+	ErrUnsupportedConversion = errors.New("reflectify: unsupported conversion")
+	ErrLossyConversion       = errors.New("reflectify: lossy conversion")
+	ErrParamNamesMismatch    = errors.New("reflectify: parameter names do not match the function parameters")
+	ErrUnexportedField       = errors.New("reflectify: field is not exported")
+	ErrTypeMismatch          = errors.New("reflectify: value does not match the reflected type")
+	ErrNotAddressable        = errors.New("reflectify: value is not addressable, pass a pointer")
+	ErrInvalidPath           = errors.New("reflectify: invalid path")
+	ErrPathNotFound          = errors.New("reflectify: path not found")
+	ErrCycle                 = errors.New("reflectify: cycle detected")
+	ErrUnusedKey             = errors.New("reflectify: key does not match any field")
+)
+
+type ArgumentError struct {
+	Index    int
+	Expected reflect.Type
+	Got      reflect.Type
+	Err      error
+}
+
+func (e *ArgumentError) Error() string {
+	got := "nil"
+	if e.Got != nil {
+		got = e.Got.String()
+	}
+
+	return fmt.Sprintf("reflectify: argument %d: expected %s, got %s", e.Index, e.Expected, got)
+}
+
+func (e *ArgumentError) Unwrap() error {
+	return e.Err
+}
+
+type UnexpectedArgumentError struct {
+	Position int
+	Got      reflect.Type
+}
+
+func (e *UnexpectedArgumentError) Error() string {
+	got := "nil"
+	if e.Got != nil {
+		got = e.Got.String()
+	}
+
+	return fmt.Sprintf("reflectify: unexpected argument at position %d of type %s", e.Position, got)
+}
+
+type ResolverError struct {
+	Index int
+	Err   error
+}
+
+func (e *ResolverError) Error() string {
+	return fmt.Sprintf("reflectify: resolving argument %d: %v", e.Index, e.Err)
+}
+
+func (e *ResolverError) Unwrap() error {
+	return e.Err
+}
+
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("reflectify: call panicked: %v", e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+type ConversionError struct {
+	From  reflect.Type
+	To    reflect.Type
+	Value any
+	Err   error
+}
+
+func (e *ConversionError) Error() string {
+	from := "nil"
+	if e.From != nil {
+		from = e.From.String()
+	}
+
+	return fmt.Sprintf("reflectify: cannot convert %v (%s) to %s: %v", e.Value, from, e.To, e.Err)
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+type NamedArgumentError struct {
+	Missing []string
+	Unknown []string
+}
+
+func (e *NamedArgumentError) Error() string {
+	problems := make([]string, 0, 2)
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing arguments "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Unknown) > 0 {
+		problems = append(problems, "unknown arguments "+strings.Join(e.Unknown, ", "))
+	}
+
+	return "reflectify: " + strings.Join(problems, "; ")
+}
+
+type PathError struct {
+	Path string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("reflectify: path %s: %v", e.Path, e.Err)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+type FieldError struct {
+	Path     string
+	Expected reflect.Type
+	Value    any
+	Err      error
+}
+
+func (e *FieldError) Error() string {
+	expected := "unknown"
+	if e.Expected != nil {
+		expected = e.Expected.String()
+	}
+
+	return fmt.Sprintf("reflectify: field %s: expected %s, got %v (%T): %v", e.Path, expected, e.Value, e.Value, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+type FillError struct {
+	Errors []*FieldError
+}
+
+func (e *FillError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
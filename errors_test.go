@@ -0,0 +1,86 @@
+package reflectify
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestArgumentError(t *testing.T) {
+	t.Run("argument error should describe expected and given type", func(t *testing.T) {
+		err := &ArgumentError{Index: 1, Expected: reflect.TypeOf(0), Got: reflect.TypeOf("")}
+
+		expected := "reflectify: argument 1: expected int, got string"
+		if err.Error() != expected {
+			t.Errorf("message expected to be '%s'. '%s' given", expected, err.Error())
+		}
+	})
+
+	t.Run("argument error should describe missing type as nil", func(t *testing.T) {
+		err := &ArgumentError{Index: 0, Expected: reflect.TypeOf(0)}
+
+		expected := "reflectify: argument 0: expected int, got nil"
+		if err.Error() != expected {
+			t.Errorf("message expected to be '%s'. '%s' given", expected, err.Error())
+		}
+	})
+}
+
+func TestPanicError(t *testing.T) {
+	t.Run("panic error should unwrap error values", func(t *testing.T) {
+		cause := errors.New("cause")
+		err := &PanicError{Value: cause}
+
+		if !errors.Is(err, cause) {
+			t.Errorf("panic error should unwrap to its cause")
+		}
+	})
+
+	t.Run("panic error should not unwrap other values", func(t *testing.T) {
+		err := &PanicError{Value: "boom"}
+
+		if errors.Unwrap(err) != nil {
+			t.Errorf("panic error should not unwrap none error values")
+		}
+	})
+}
+
+func TestNamedArgumentError(t *testing.T) {
+	t.Run("named argument error should list missing and unknown names", func(t *testing.T) {
+		err := &NamedArgumentError{Missing: []string{"id"}, Unknown: []string{"page", "size"}}
+
+		expected := "reflectify: missing arguments id; unknown arguments page, size"
+		if err.Error() != expected {
+			t.Errorf("message expected to be '%s'. '%s' given", expected, err.Error())
+		}
+	})
+}
+
+func TestPathError(t *testing.T) {
+	t.Run("path error should describe path and cause", func(t *testing.T) {
+		err := &PathError{Path: "Items[3]", Err: ErrPathNotFound}
+
+		expected := "reflectify: path Items[3]: reflectify: path not found"
+		if err.Error() != expected {
+			t.Errorf("message expected to be '%s'. '%s' given", expected, err.Error())
+		}
+		if !errors.Is(err, ErrPathNotFound) {
+			t.Errorf("path error should unwrap to its cause")
+		}
+	})
+}
+
+func TestFillError(t *testing.T) {
+	t.Run("fill error should list every field error", func(t *testing.T) {
+		err := &FillError{Errors: []*FieldError{
+			{Path: "age", Expected: reflect.TypeOf(0), Value: "abc", Err: errors.New("invalid syntax")},
+			{Path: "name", Value: 5, Err: errors.New("unknown")},
+		}}
+
+		expected := "reflectify: field age: expected int, got abc (string): invalid syntax; " +
+			"reflectify: field name: expected unknown, got 5 (int): unknown"
+		if err.Error() != expected {
+			t.Errorf("message expected to be '%s'. '%s' given", expected, err.Error())
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package reflectify
+
+import (
+	"reflect"
+)
+
+type Field struct {
+	field      reflect.StructField
+	owner      reflect.Type
+	declaredBy reflect.Type
+	shadowed   bool
+	mapping    mappingOptions
+}
+
+func (r *Reflection) Fields(options ...MemberOption) []*Field {
+	result := make([]*Field, 0)
+	option := combineMemberOptions(options)
+
+	if r.t == nil {
+		return result
+	}
+
+	owner := elemType(r.t)
+	if owner.Kind() != reflect.Struct {
+		return result
+	}
+
+	for _, member := range structMembers(owner) {
+		if !member.field.IsExported() && !option.has(IncludeUnexported) {
+			continue
+		}
+		if member.shadowed && !option.has(IncludeShadowed) {
+			continue
+		}
+		if len(member.field.Index) > 1 && option.has(DeclaredOnly) {
+			continue
+		}
+
+		result = append(result, &Field{
+			field:      member.field,
+			owner:      owner,
+			declaredBy: member.declaredBy,
+			shadowed:   member.shadowed,
+			mapping:    r.mappingOptions(),
+		})
+	}
+
+	return result
+}
+
+func (r *Reflection) FieldByName(name string, options ...MemberOption) *Field {
+	for _, field := range r.Fields(options...) {
+		if field.Name() == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func (f *Field) Name() string {
+	return f.field.Name
+}
+
+func (f *Field) Type() *Reflection {
+	return reflectType(f.field.Type)
+}
+
+func (f *Field) Tag(key string) (string, bool) {
+	return f.field.Tag.Lookup(key)
+}
+
+func (f *Field) IsExported() bool {
+	return f.field.IsExported()
+}
+
+func (f *Field) PkgPath() string {
+	return f.declaredBy.PkgPath()
+}
+
+func (f *Field) Index() []int {
+	index := make([]int, len(f.field.Index))
+	copy(index, f.field.Index)
+
+	return index
+}
+
+func (f *Field) IsEmbedded() bool {
+	return f.field.Anonymous
+}
+
+func (f *Field) DeclaredBy() *Reflection {
+	return reflectType(f.declaredBy)
+}
+
+func (f *Field) IsPromoted() bool {
+	return len(f.field.Index) > 1
+}
+
+func (f *Field) IsShadowed() bool {
+	return f.shadowed
+}
+
+func (f *Field) Get(obj any) (any, error) {
+	if !f.IsExported() {
+		return nil, ErrUnexportedField
+	}
+
+	v, err := f.structValue(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	field, err := v.FieldByIndexErr(f.field.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	return field.Interface(), nil
+}
+
+func (f *Field) Set(obj any, value any) error {
+	if !f.IsExported() {
+		return ErrUnexportedField
+	}
+
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return ErrNotAddressable
+	}
+
+	v, err := f.structValue(obj)
+	if err != nil {
+		return err
+	}
+
+	field, err := fieldByIndex(v, f.field.Index)
+	if err != nil {
+		return err
+	}
+
+	converted, err := convertValue(value, f.field.Type, f.mapping)
+	if err != nil {
+		return err
+	}
+
+	field.Set(converted)
+
+	return nil
+}
+
+func (f *Field) structValue(obj any) (reflect.Value, error) {
+	v := reflect.ValueOf(obj)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if !v.IsValid() || v.Type() != f.owner {
+		return reflect.Value{}, ErrTypeMismatch
+	}
+
+	return v, nil
+}
+
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, ErrNotAddressable
+				}
+
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(i)
+	}
+
+	return v, nil
+}
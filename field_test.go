@@ -0,0 +1,227 @@
+package reflectify
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	t.Run("fields should return exported fields", func(t *testing.T) {
+		refl := Reflect(TestStruct{})
+
+		fields := refl.Fields()
+
+		if len(fields) != 1 || fields[0].Name() != "Field1" {
+			t.Errorf("fields should only contain Field1. %d fields given", len(fields))
+		}
+	})
+
+	t.Run("fields should be available for pointers", func(t *testing.T) {
+		refl := Reflect(&TestStruct{})
+
+		fields := refl.Fields()
+
+		if len(fields) != 1 {
+			t.Errorf("current len of %d does not match expected %d", len(fields), 1)
+		}
+	})
+
+	t.Run("fields should contain embedded and promoted fields", func(t *testing.T) {
+		refl := Reflect(testOrder{})
+
+		names := make([]string, 0)
+		for _, field := range refl.Fields() {
+			names = append(names, field.Name())
+		}
+
+		expected := []string{"TestAudit", "CreatedBy", "ID", "Customer", "Items"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("field names %v given. expected: %v", names, expected)
+		}
+	})
+
+	t.Run("fields should contain unexported fields on request", func(t *testing.T) {
+		fields := Reflect(TestStruct{}).Fields(IncludeUnexported)
+
+		if len(fields) != 4 {
+			t.Fatalf("current len of %d does not match expected %d", len(fields), 4)
+		}
+
+		field := fields[1]
+		if field.Name() != "field2" || field.IsExported() || field.Type().t != reflect.TypeOf(0) {
+			t.Errorf("field2 should be reported as unexported int")
+		}
+		if field.PkgPath() != "github.com/evolidev/reflectify" {
+			t.Errorf("package path '%s' given. expected: '%s'", field.PkgPath(), "github.com/evolidev/reflectify")
+		}
+	})
+
+	t.Run("fields of none struct should be empty", func(t *testing.T) {
+		refl := Reflect(func() {})
+
+		if len(refl.Fields()) != 0 {
+			t.Errorf("fields should be empty")
+		}
+	})
+
+	t.Run("fields of nil should be empty", func(t *testing.T) {
+		refl := Reflect(nil)
+
+		if len(refl.Fields()) != 0 || refl.FieldByName("ID") != nil {
+			t.Errorf("fields should be empty")
+		}
+	})
+}
+
+func TestFieldByName(t *testing.T) {
+	t.Run("field by name should describe the field", func(t *testing.T) {
+		field := Reflect(testOrder{}).FieldByName("ID")
+
+		if field == nil {
+			t.Fatalf("field should exist")
+		}
+		if tag, ok := field.Tag("json"); !ok || tag != "id" {
+			t.Errorf("tag '%s' given. expected: '%s'", tag, "id")
+		}
+		if !field.IsExported() || field.IsEmbedded() {
+			t.Errorf("field should be exported and not embedded")
+		}
+		if !reflect.DeepEqual(field.Index(), []int{1}) {
+			t.Errorf("index %v given. expected: %v", field.Index(), []int{1})
+		}
+		if !field.Type().IsScalar() {
+			t.Errorf("type of field should be scalar")
+		}
+	})
+
+	t.Run("field by name should describe promoted fields", func(t *testing.T) {
+		field := Reflect(testOrder{}).FieldByName("CreatedBy")
+
+		if !reflect.DeepEqual(field.Index(), []int{0, 0}) {
+			t.Errorf("index %v given. expected: %v", field.Index(), []int{0, 0})
+		}
+	})
+
+	t.Run("field by name should return nil if field does not exist", func(t *testing.T) {
+		if Reflect(testOrder{}).FieldByName("DoesNotExist") != nil {
+			t.Errorf("field should not exist")
+		}
+	})
+}
+
+func TestFieldGetAndSet(t *testing.T) {
+	t.Run("get should return the field value", func(t *testing.T) {
+		order := testOrder{ID: 5}
+
+		value, err := Reflect(order).FieldByName("ID").Get(order)
+
+		if err != nil || value != 5 {
+			t.Errorf("value '%v' given. expected: %d. error: %v", value, 5, err)
+		}
+	})
+
+	t.Run("get should return promoted field value of pointer", func(t *testing.T) {
+		order := &testOrder{TestAudit: &TestAudit{CreatedBy: "john"}}
+
+		value, err := Reflect(order).FieldByName("CreatedBy").Get(order)
+
+		if err != nil || value != "john" {
+			t.Errorf("value '%v' given. expected: %s. error: %v", value, "john", err)
+		}
+	})
+
+	t.Run("get should return error for nil embedded pointer", func(t *testing.T) {
+		order := testOrder{}
+
+		_, err := Reflect(order).FieldByName("CreatedBy").Get(order)
+
+		if err == nil {
+			t.Errorf("expected error for nil embedded pointer")
+		}
+	})
+
+	t.Run("get should return error for other types", func(t *testing.T) {
+		_, err := Reflect(testOrder{}).FieldByName("ID").Get(TestStruct{})
+
+		if !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("expected type mismatch error. %v given", err)
+		}
+	})
+
+	t.Run("get and set should refuse unexported fields", func(t *testing.T) {
+		value := &TestStruct{}
+		field := Reflect(value).FieldByName("field2", IncludeUnexported)
+
+		if _, err := field.Get(value); !errors.Is(err, ErrUnexportedField) {
+			t.Errorf("expected unexported field error. %v given", err)
+		}
+		if err := field.Set(value, 1); !errors.Is(err, ErrUnexportedField) || value.field2 != 0 {
+			t.Errorf("expected unexported field error. %v given", err)
+		}
+	})
+
+	t.Run("set should convert the value", func(t *testing.T) {
+		order := &testOrder{}
+
+		err := Reflect(order).FieldByName("ID").Set(order, "7")
+
+		if err != nil || order.ID != 7 {
+			t.Errorf("value '%d' given. expected: %d. error: %v", order.ID, 7, err)
+		}
+	})
+
+	t.Run("set should allocate embedded pointers", func(t *testing.T) {
+		order := &testOrder{}
+
+		err := Reflect(order).FieldByName("CreatedBy").Set(order, "john")
+
+		if err != nil || order.TestAudit == nil || order.CreatedBy != "john" {
+			t.Errorf("promoted field not set. error: %v", err)
+		}
+	})
+
+	t.Run("set should require a pointer", func(t *testing.T) {
+		err := Reflect(testOrder{}).FieldByName("ID").Set(testOrder{}, 1)
+
+		if !errors.Is(err, ErrNotAddressable) {
+			t.Errorf("expected not addressable error. %v given", err)
+		}
+	})
+
+	t.Run("set should return conversion errors", func(t *testing.T) {
+		order := &testOrder{}
+
+		err := Reflect(order).FieldByName("Items").Set(order, "test")
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("expected conversion error. %v given", err)
+		}
+	})
+}
+
+type TestAudit struct {
+	CreatedBy string
+}
+
+type testCustomer struct {
+	Name    string
+	Address testAddress
+}
+
+type testAddress struct {
+	City string
+}
+
+type testItem struct {
+	Price float64
+}
+
+type testOrder struct {
+	*TestAudit
+	ID       int `json:"id"`
+	Customer testCustomer
+	Items    []testItem
+	note     string
+}
@@ -0,0 +1,379 @@
+package reflectify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+type FillOptions struct {
+	TagName     string
+	Strict      bool
+	ErrorUnused bool
+	ZeroFields  bool
+	Squash      bool
+	DecodeHooks []mapstructure.DecodeHookFunc
+}
+
+var defaultFillOptions struct {
+	sync.RWMutex
+	options FillOptions
+}
+
+func SetDefaultFillOptions(options FillOptions) {
+	defaultFillOptions.Lock()
+	defer defaultFillOptions.Unlock()
+
+	defaultFillOptions.options = options
+}
+
+func DefaultFillOptions() FillOptions {
+	defaultFillOptions.RLock()
+	defer defaultFillOptions.RUnlock()
+
+	return defaultFillOptions.options
+}
+
+func (r *Reflection) FillE(input any) (any, error) {
+	return r.FillWith(input, DefaultFillOptions())
+}
+
+func (r *Reflection) FillWith(input any, options FillOptions) (any, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	elem := r.element
+
+	input, err := fillInput(input, elemType(r.t))
+	if err != nil {
+		return elem, err
+	}
+
+	var unused []string
+	if !r.IsPointer() {
+		unused, err = decode(input, &elem, options)
+	} else {
+		unused, err = decode(input, elem, options)
+	}
+
+	if err != nil || len(unused) > 0 {
+		return elem, newFillError(err, unused, input, r.t, options.TagName)
+	}
+
+	return elem, nil
+}
+
+func (r *Reflection) fill(input any) (any, error) {
+	return r.FillE(input)
+}
+
+func fillInput(input any, t reflect.Type) (any, error) {
+	switch value := input.(type) {
+	case url.Values:
+		return flattenValues(value), nil
+	case http.Header:
+		return flattenValues(value), nil
+	case map[string][]string:
+		return flattenValues(value), nil
+	case []byte:
+		if !isByteSequence(t) {
+			return decodeJSON(value, t)
+		}
+	case io.Reader:
+		data, err := io.ReadAll(value)
+		if err != nil {
+			return nil, &FillError{Errors: []*FieldError{{Expected: t, Err: err}}}
+		}
+
+		return decodeJSON(data, t)
+	}
+
+	return input, nil
+}
+
+func isByteSequence(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8
+}
+
+type multiValue []string
+
+var multiValueType = reflect.TypeOf(multiValue{})
+
+func flattenValues(values map[string][]string) map[string]any {
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		result[key] = multiValue(value)
+	}
+
+	return result
+}
+
+func flattenMultiValue(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from != multiValueType {
+		return data, nil
+	}
+
+	values := []string(data.(multiValue))
+	for to.Kind() == reflect.Ptr {
+		to = to.Elem()
+	}
+
+	if len(values) == 1 && to.Kind() != reflect.Slice && to.Kind() != reflect.Array {
+		return values[0], nil
+	}
+
+	return values, nil
+}
+
+func decodeJSON(data []byte, t reflect.Type) (any, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var result any
+	err := decoder.Decode(&result)
+	if err == nil {
+		if _, tokenErr := decoder.Token(); tokenErr != io.EOF {
+			err = errTrailingJSON
+		}
+	}
+
+	if err != nil {
+		return nil, &FillError{Errors: []*FieldError{{Expected: t, Value: string(data), Err: err}}}
+	}
+
+	return result, nil
+}
+
+var (
+	errTrailingJSON = errors.New("invalid data after top-level value")
+	jsonNumberType  = reflect.TypeOf(json.Number(""))
+)
+
+func decodeJSONNumber(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from != jsonNumberType {
+		return data, nil
+	}
+
+	number := data.(json.Number)
+	for to.Kind() == reflect.Ptr {
+		to = to.Elem()
+	}
+
+	switch to.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i, err := number.Int64(); err == nil {
+			return i, nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u, err := strconv.ParseUint(number.String(), 10, 64); err == nil {
+			return u, nil
+		}
+	case reflect.String:
+		return number.String(), nil
+	}
+
+	return number.Float64()
+}
+
+func decode(input any, result any, options FillOptions) ([]string, error) {
+	metadata := &mapstructure.Metadata{}
+	config := &mapstructure.DecoderConfig{
+		Result:           result,
+		Metadata:         metadata,
+		WeaklyTypedInput: !options.Strict,
+		ZeroFields:       options.ZeroFields,
+		Squash:           options.Squash,
+		TagName:          options.TagName,
+	}
+
+	hooks := append([]mapstructure.DecodeHookFunc{flattenMultiValue, decodeJSONNumber}, options.DecodeHooks...)
+	config.DecodeHook = mapstructure.ComposeDecodeHookFunc(hooks...)
+
+	decoder, err := mapstructure.NewDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(input); err != nil {
+		return nil, err
+	}
+
+	if !options.ErrorUnused {
+		return nil, nil
+	}
+
+	return metadata.Unused, nil
+}
+
+func newFillError(err error, unused []string, input any, t reflect.Type, tagName string) *FillError {
+	var messages []string
+
+	var decodeErr *mapstructure.Error
+	if errors.As(err, &decodeErr) {
+		messages = decodeErr.Errors
+	} else if err != nil {
+		messages = []string{err.Error()}
+	}
+
+	if tagName == "" {
+		tagName = "mapstructure"
+	}
+
+	fillErr := &FillError{Errors: make([]*FieldError, 0, len(messages)+len(unused))}
+	for _, key := range unused {
+		fillErr.Errors = append(fillErr.Errors, newFieldError(key, ErrUnusedKey, input, t, tagName))
+	}
+
+	for _, message := range messages {
+		fieldErr := newFieldError(messagePath(message), errors.New(message), input, t, tagName)
+		fillErr.Errors = append(fillErr.Errors, fieldErr)
+	}
+
+	sort.Slice(fillErr.Errors, func(i, j int) bool {
+		return fillErr.Errors[i].Path < fillErr.Errors[j].Path
+	})
+
+	return fillErr
+}
+
+func newFieldError(path string, err error, input any, t reflect.Type, tagName string) *FieldError {
+	fieldErr := &FieldError{Path: path, Err: err}
+	if segments, err := parsePath(path); err == nil {
+		fieldErr.Expected = fillPathType(t, segments, tagName)
+		fieldErr.Value = fillPathValue(input, segments)
+	}
+
+	return fieldErr
+}
+
+func messagePath(message string) string {
+	start := strings.IndexByte(message, '\'')
+	if start < 0 {
+		return ""
+	}
+
+	end := strings.IndexByte(message[start+1:], '\'')
+	if end < 0 {
+		return ""
+	}
+
+	return message[start+1 : start+1+end]
+}
+
+func fillPathType(t reflect.Type, segments []pathSegment, tagName string) reflect.Type {
+	for _, segment := range segments {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Struct:
+			field, found := fillField(t, segment.key, tagName)
+			if !found {
+				return nil
+			}
+
+			t = field.Type
+		case reflect.Map, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		default:
+			return nil
+		}
+	}
+
+	return t
+}
+
+func fillField(t reflect.Type, name string, tagName string) (reflect.StructField, bool) {
+	for _, member := range structMembers(t) {
+		if member.shadowed || !member.field.IsExported() {
+			continue
+		}
+
+		tag := strings.SplitN(member.field.Tag.Get(tagName), ",", 2)[0]
+		if tag == name || strings.EqualFold(member.field.Name, name) {
+			return member.field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func fillPathValue(input any, segments []pathSegment) any {
+	v := reflect.ValueOf(input)
+
+	for _, segment := range segments {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+
+		switch v.Kind() {
+		case reflect.Map:
+			v = fillMapValue(v, segment.key)
+		case reflect.Slice, reflect.Array:
+			index, err := strconv.Atoi(segment.key)
+			if err != nil || index < 0 || index >= v.Len() {
+				return nil
+			}
+
+			v = v.Index(index)
+		case reflect.Struct:
+			v = v.FieldByNameFunc(func(name string) bool {
+				return strings.EqualFold(name, segment.key)
+			})
+		default:
+			return nil
+		}
+
+		if !v.IsValid() || !v.CanInterface() {
+			return nil
+		}
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	if values, ok := v.Interface().(multiValue); ok {
+		if len(values) == 1 {
+			return values[0]
+		}
+
+		return []string(values)
+	}
+
+	return v.Interface()
+}
+
+func fillMapValue(v reflect.Value, key string) reflect.Value {
+	var folded reflect.Value
+
+	iter := v.MapRange()
+	for iter.Next() {
+		name := fmt.Sprint(iter.Key().Interface())
+		if name == key {
+			return iter.Value()
+		}
+
+		if !folded.IsValid() && strings.EqualFold(name, key) {
+			folded = iter.Value()
+		}
+	}
+
+	return folded
+}
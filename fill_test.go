@@ -0,0 +1,492 @@
+package reflectify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+func TestFillWith(t *testing.T) {
+	t.Run("fill with should honor the tag name", func(t *testing.T) {
+		refl := Reflect(&testProfile{})
+
+		result, err := refl.FillWith(map[string]any{"first_name": "john"}, FillOptions{TagName: "json"})
+
+		if err != nil || result.(*testProfile).FirstName != "john" {
+			t.Errorf("first name should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill with should convert weakly typed input by default", func(t *testing.T) {
+		refl := Reflect(&testProfile{})
+
+		result, err := refl.FillWith(map[string]any{"age": "5"}, FillOptions{TagName: "json"})
+
+		if err != nil || result.(*testProfile).Age != 5 {
+			t.Errorf("age should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill with should refuse weakly typed input in strict mode", func(t *testing.T) {
+		refl := Reflect(&testProfile{})
+
+		_, err := refl.FillWith(map[string]any{"age": "5"}, FillOptions{TagName: "json", Strict: true})
+
+		if err == nil {
+			t.Errorf("expected error in strict mode")
+		}
+	})
+
+	t.Run("fill with should report unused keys on request", func(t *testing.T) {
+		refl := Reflect(&testProfile{})
+
+		_, err := refl.FillWith(map[string]any{"unknown": 1}, FillOptions{ErrorUnused: true})
+
+		if err == nil {
+			t.Errorf("expected error for unused key")
+		}
+	})
+
+	t.Run("fill with should zero maps on request", func(t *testing.T) {
+		input := map[string]any{"labels": map[string]string{"b": "2"}}
+
+		merged, _ := Reflect(&testProfile{Labels: map[string]string{"a": "1"}}).FillWith(input, FillOptions{TagName: "json"})
+		zeroed, _ := Reflect(&testProfile{Labels: map[string]string{"a": "1"}}).FillWith(input, FillOptions{TagName: "json", ZeroFields: true})
+
+		if len(merged.(*testProfile).Labels) != 2 {
+			t.Errorf("labels should be merged. %v given", merged.(*testProfile).Labels)
+		}
+		if !reflect.DeepEqual(zeroed.(*testProfile).Labels, map[string]string{"b": "2"}) {
+			t.Errorf("labels should be replaced. %v given", zeroed.(*testProfile).Labels)
+		}
+	})
+
+	t.Run("fill with should squash embedded structs on request", func(t *testing.T) {
+		refl := Reflect(&testProfile{})
+
+		result, err := refl.FillWith(map[string]any{"city": "Zurich"}, FillOptions{TagName: "json", Squash: true})
+
+		if err != nil || result.(*testProfile).City != "Zurich" {
+			t.Errorf("embedded city should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill with should run decode hooks", func(t *testing.T) {
+		refl := Reflect(testProfile{})
+
+		options := FillOptions{
+			TagName:     "json",
+			DecodeHooks: []mapstructure.DecodeHookFunc{mapstructure.StringToTimeDurationHookFunc()},
+		}
+		result, err := refl.FillWith(map[string]any{"timeout": "5s"}, options)
+
+		if err != nil || result.(testProfile).Timeout != 5*time.Second {
+			t.Errorf("timeout should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill with should keep every concurrent fill", func(t *testing.T) {
+		type tagged struct {
+			Tags map[string]int
+		}
+
+		element := &tagged{Tags: map[string]int{"a": 1}}
+		refl := Reflect(element)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				refl.Fill(map[string]any{"tags": map[string]any{"a": i, fmt.Sprint("k", i): i}})
+			}(i)
+		}
+		wg.Wait()
+
+		if tags := element.Tags; len(tags) != 21 {
+			t.Errorf("every fill should be kept. %v given", tags)
+		}
+	})
+}
+
+func TestDefaultFillOptions(t *testing.T) {
+	t.Run("fill should use the default options", func(t *testing.T) {
+		previous := DefaultFillOptions()
+		defer SetDefaultFillOptions(previous)
+
+		SetDefaultFillOptions(FillOptions{TagName: "json"})
+
+		result := Reflect(&testProfile{}).Fill(map[string]any{"first_name": "john"})
+
+		if result.(*testProfile).FirstName != "john" {
+			t.Errorf("first name should be filled. %+v given", result)
+		}
+	})
+
+	t.Run("zero default options should keep mapstructure tags", func(t *testing.T) {
+		result := Reflect(&testProfile{}).Fill(map[string]any{"firstname": "john"})
+
+		if result.(*testProfile).FirstName != "john" {
+			t.Errorf("first name should be filled. %+v given", result)
+		}
+	})
+
+	t.Run("conversions should use the default options", func(t *testing.T) {
+		previous := DefaultFillOptions()
+		defer SetDefaultFillOptions(previous)
+
+		SetDefaultFillOptions(FillOptions{TagName: "json"})
+
+		profile, err := To[testProfile](map[string]any{"first_name": "john"})
+
+		if err != nil || profile.FirstName != "john" {
+			t.Errorf("first name should be filled. %+v given. error: %v", profile, err)
+		}
+	})
+}
+
+type testLocation struct {
+	City string `json:"city"`
+}
+
+type testProfile struct {
+	testLocation
+	FirstName string            `json:"first_name"`
+	Age       int               `json:"age"`
+	Labels    map[string]string `json:"labels"`
+	Timeout   time.Duration     `json:"timeout"`
+}
+
+func TestFillE(t *testing.T) {
+	t.Run("fill e should fill the element", func(t *testing.T) {
+		result, err := Reflect(&TestStruct{}).FillE(map[string]any{"field1": "test"})
+
+		if err != nil || result.(*TestStruct).Field1 != "test" {
+			t.Errorf("field should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill e should report every failing field", func(t *testing.T) {
+		input := map[string]any{
+			"age":    "abc",
+			"Labels": "test",
+			"Orders": []any{map[string]any{"ID": 1}, map[string]any{"id": "x"}},
+		}
+
+		_, err := Reflect(&testAccountForm{}).FillE(input)
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || len(fillErr.Errors) != 3 {
+			t.Fatalf("expected fill error with 3 field errors. %v given", err)
+		}
+
+		expected := []struct {
+			path     string
+			expected reflect.Type
+			value    any
+		}{
+			{"Age", reflect.TypeOf(0), "abc"},
+			{"Labels", reflect.TypeOf(map[string]string{}), "test"},
+			{"Orders[1].ID", reflect.TypeOf(0), "x"},
+		}
+
+		for i, e := range expected {
+			fieldErr := fillErr.Errors[i]
+			if fieldErr.Path != e.path || fieldErr.Expected != e.expected || fieldErr.Value != e.value {
+				t.Errorf("field error %+v does not match expected %+v", fieldErr, e)
+			}
+		}
+	})
+
+	t.Run("fill e should report nested slice paths", func(t *testing.T) {
+		input := map[string]any{"items": []any{map[string]any{"price": "abc"}}}
+
+		_, err := Reflect(&testInvoice{}).FillWith(input, FillOptions{TagName: "json"})
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || len(fillErr.Errors) != 1 {
+			t.Fatalf("expected fill error with 1 field error. %v given", err)
+		}
+
+		fieldErr := fillErr.Errors[0]
+		if fieldErr.Path != "items[0].price" || fieldErr.Expected != reflect.TypeOf(0.0) || fieldErr.Value != "abc" {
+			t.Errorf("field error %+v does not match expected path %s", fieldErr, "items[0].price")
+		}
+	})
+
+	t.Run("fill e should report every unused key", func(t *testing.T) {
+		input := map[string]any{
+			"number": "1",
+			"extra":  true,
+			"items":  []any{map[string]any{"price": 1.5, "discount": 0.5}},
+		}
+
+		_, err := Reflect(&testInvoice{}).FillWith(input, FillOptions{TagName: "json", ErrorUnused: true})
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || len(fillErr.Errors) != 2 {
+			t.Fatalf("expected fill error with 2 field errors. %v given", err)
+		}
+
+		expected := []struct {
+			path  string
+			value any
+		}{
+			{"extra", true},
+			{"items[0].discount", 0.5},
+		}
+
+		for i, e := range expected {
+			fieldErr := fillErr.Errors[i]
+			if fieldErr.Path != e.path || fieldErr.Expected != nil || fieldErr.Value != e.value || !errors.Is(fieldErr, ErrUnusedKey) {
+				t.Errorf("field error %+v does not match expected %+v", fieldErr, e)
+			}
+		}
+	})
+
+	t.Run("fill e should keep unused keys containing separators intact", func(t *testing.T) {
+		_, err := Reflect(&testInvoice{}).FillWith(map[string]any{"a, b": 1}, FillOptions{TagName: "json", ErrorUnused: true})
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || len(fillErr.Errors) != 1 {
+			t.Fatalf("expected fill error with 1 field error. %v given", err)
+		}
+
+		if fieldErr := fillErr.Errors[0]; fieldErr.Path != "a, b" || !errors.Is(fieldErr, ErrUnusedKey) {
+			t.Errorf("expected unused key error for %q. %+v given", "a, b", fieldErr)
+		}
+	})
+
+	t.Run("fill e should honor the tag name of the default options", func(t *testing.T) {
+		previous := DefaultFillOptions()
+		defer SetDefaultFillOptions(previous)
+
+		SetDefaultFillOptions(FillOptions{TagName: "json"})
+
+		_, err := Reflect(&testProfile{}).FillE(map[string]any{"age": "abc"})
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || fillErr.Errors[0].Path != "age" || fillErr.Errors[0].Expected != reflect.TypeOf(0) {
+			t.Errorf("expected field error for age. %v given", err)
+		}
+	})
+
+	t.Run("fill e should report invalid input", func(t *testing.T) {
+		_, err := Reflect(&TestStruct{}).FillE("test")
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || fillErr.Errors[0].Path != "" || fillErr.Errors[0].Value != "test" {
+			t.Errorf("expected field error for root. %v given", err)
+		}
+	})
+
+	t.Run("fill e should report unused keys", func(t *testing.T) {
+		_, err := Reflect(&TestStruct{}).FillWith(map[string]any{"unknown": 1}, FillOptions{ErrorUnused: true})
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || !strings.Contains(fillErr.Error(), "unknown") {
+			t.Errorf("expected fill error for unknown key. %v given", err)
+		}
+	})
+}
+
+type testAccountOrder struct {
+	ID int
+}
+
+type testAccountForm struct {
+	Age    int
+	Labels map[string]string
+	Orders []testAccountOrder
+}
+
+type testInvoiceItem struct {
+	Price float64 `json:"price"`
+}
+
+type testInvoice struct {
+	Number string            `json:"number"`
+	Items  []testInvoiceItem `json:"items"`
+}
+
+func TestFillInput(t *testing.T) {
+	options := FillOptions{TagName: "json"}
+
+	t.Run("fill should decode json bytes", func(t *testing.T) {
+		result, err := Reflect(&testProfile{}).FillWith([]byte(`{"first_name": "john", "age": "5"}`), options)
+
+		profile := result.(*testProfile)
+		if err != nil || profile.FirstName != "john" || profile.Age != 5 {
+			t.Errorf("profile should be filled. %+v given. error: %v", profile, err)
+		}
+	})
+
+	t.Run("fill should keep large json integers exact", func(t *testing.T) {
+		var target struct {
+			ID    int64   `json:"id"`
+			Count uint64  `json:"count"`
+			Ratio float64 `json:"ratio"`
+			Age   int     `json:"age"`
+		}
+
+		data := []byte(`{"id": 9007199254740993, "count": 18446744073709551615, "ratio": 0.5, "age": 30.0}`)
+		_, err := Reflect(&target).FillWith(data, options)
+
+		if err != nil || target.ID != 9007199254740993 || target.Count != 18446744073709551615 || target.Ratio != 0.5 || target.Age != 30 {
+			t.Errorf("numbers should be filled exactly. %+v given. error: %v", target, err)
+		}
+	})
+
+	t.Run("fill should keep the literal of json numbers in string targets", func(t *testing.T) {
+		var target struct {
+			Reference string `json:"reference"`
+		}
+
+		_, err := Reflect(&target).FillWith([]byte(`{"reference": 12345678901234567890}`), options)
+
+		if err != nil || target.Reference != "12345678901234567890" {
+			t.Errorf("reference should be filled with the number literal. %q given. error: %v", target.Reference, err)
+		}
+	})
+
+	t.Run("fill should keep floats for json numbers in untyped targets", func(t *testing.T) {
+		result, err := Reflect(&map[string]any{}).FillE([]byte(`{"id": 5}`))
+
+		if err != nil || (*result.(*map[string]any))["id"] != 5.0 {
+			t.Errorf("number should be decoded as float. %v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill should report trailing json data", func(t *testing.T) {
+		_, err := Reflect(&testProfile{}).FillE([]byte(`{"age": 5} {}`))
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) {
+			t.Errorf("expected fill error for trailing json. %v given", err)
+		}
+	})
+
+	t.Run("fill should decode json bytes into slices", func(t *testing.T) {
+		result, err := Reflect(&[]map[string]any{}).FillE([]byte(`[{"name":"a"}]`))
+
+		expected := []map[string]any{{"name": "a"}}
+		if err != nil || !reflect.DeepEqual(*result.(*[]map[string]any), expected) {
+			t.Errorf("slice %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("fill should keep bytes for byte slices", func(t *testing.T) {
+		result, err := Reflect(&[]byte{}).FillE([]byte(`[1]`))
+
+		if err != nil || string(*result.(*[]byte)) != "[1]" {
+			t.Errorf("bytes %s given. expected: %s. error: %v", result, "[1]", err)
+		}
+	})
+
+	t.Run("fill should decode json readers", func(t *testing.T) {
+		result, err := Reflect(testProfile{}).FillWith(strings.NewReader(`{"labels": {"a": "1"}}`), options)
+
+		if err != nil || result.(testProfile).Labels["a"] != "1" {
+			t.Errorf("labels should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill should ignore empty bodies", func(t *testing.T) {
+		result, err := Reflect(&testProfile{FirstName: "john"}).FillWith(strings.NewReader(" "), options)
+
+		if err != nil || result.(*testProfile).FirstName != "john" {
+			t.Errorf("profile should be unchanged. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill should report invalid json", func(t *testing.T) {
+		_, err := Reflect(&testProfile{}).FillE([]byte(`{"first_name":`))
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || fillErr.Errors[0].Value != `{"first_name":` {
+			t.Errorf("expected fill error for invalid json. %v given", err)
+		}
+	})
+
+	t.Run("fill should flatten url values", func(t *testing.T) {
+		values := url.Values{"first_name": {"john"}, "age": {"5"}, "tags": {"a", "b"}}
+
+		result, err := Reflect(&testQuery{}).FillWith(values, options)
+
+		query := result.(*testQuery)
+		if err != nil || query.FirstName != "john" || query.Age != 5 || !reflect.DeepEqual(query.Tags, []string{"a", "b"}) {
+			t.Errorf("query should be filled. %+v given. error: %v", query, err)
+		}
+	})
+
+	t.Run("fill should promote single url values to slices", func(t *testing.T) {
+		result, err := Reflect(&testQuery{}).FillWith(url.Values{"tags": {"a"}}, options)
+
+		if err != nil || !reflect.DeepEqual(result.(*testQuery).Tags, []string{"a"}) {
+			t.Errorf("tags should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill should keep single url values for slices in strict mode", func(t *testing.T) {
+		values := url.Values{"tags": {"a"}, "first_name": {"john"}}
+
+		result, err := Reflect(&testQuery{}).FillWith(values, FillOptions{TagName: "json", Strict: true})
+
+		query := result.(*testQuery)
+		if err != nil || query.FirstName != "john" || !reflect.DeepEqual(query.Tags, []string{"a"}) {
+			t.Errorf("query should be filled. %+v given. error: %v", query, err)
+		}
+	})
+
+	t.Run("fill should flatten single url values for any fields", func(t *testing.T) {
+		result, err := Reflect(&map[string]any{}).FillE(url.Values{"a": {"1"}, "b": {"1", "2"}})
+
+		expected := map[string]any{"a": "1", "b": []string{"1", "2"}}
+		if err != nil || !reflect.DeepEqual(*result.(*map[string]any), expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("fill should report url values with their given values", func(t *testing.T) {
+		_, err := Reflect(&testQuery{}).FillWith(url.Values{"age": {"x"}}, FillOptions{TagName: "json"})
+
+		var fillErr *FillError
+		if !errors.As(err, &fillErr) || fillErr.Errors[0].Path != "age" || fillErr.Errors[0].Value != "x" {
+			t.Errorf("expected field error for age. %v given", err)
+		}
+	})
+
+	t.Run("fill should flatten http headers", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("X-Request-Id", "42")
+
+		result, err := Reflect(&testQuery{}).FillWith(header, FillOptions{TagName: "header"})
+
+		if err != nil || result.(*testQuery).RequestID != 42 {
+			t.Errorf("request id should be filled. %+v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("fill should accept string maps", func(t *testing.T) {
+		result, err := Reflect(&testQuery{}).FillWith(map[string]string{"age": "7"}, options)
+
+		if err != nil || result.(*testQuery).Age != 7 {
+			t.Errorf("age should be filled. %+v given. error: %v", result, err)
+		}
+	})
+}
+
+type testQuery struct {
+	FirstName string   `json:"first_name"`
+	Age       int      `json:"age"`
+	Tags      []string `json:"tags"`
+	RequestID int      `header:"X-Request-Id"`
+}
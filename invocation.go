@@ -0,0 +1,97 @@
+package reflectify
+
+import "reflect"
+
+type invocation struct {
+	resolvers       []ParamResolver
+	defaultResolver ParamResolver
+	mapping         mappingOptions
+	cursor          *argumentCursor
+}
+
+func (r *Reflection) newInvocation(parameters []any) *invocation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return &invocation{
+		resolvers:       r.resolvers,
+		defaultResolver: r.defaultResolver,
+		mapping:         r.mapping,
+		cursor:          newArgumentCursor(parameters),
+	}
+}
+
+func (i *invocation) resolve(currentInputParam *Reflection) reflect.Value {
+	if resolved, ok := i.resolveCustom(currentInputParam); ok {
+		return resolved
+	}
+
+	param := i.cursor.current()
+
+	if i.defaultResolver != nil {
+		if resolved, ok := i.apply(i.defaultResolver, currentInputParam, param); ok {
+			return resolved
+		}
+	}
+
+	i.cursor.advance()
+
+	return reflect.ValueOf(param)
+}
+
+func (i *invocation) resolveCustom(currentInputParam *Reflection) (reflect.Value, bool) {
+	param := i.cursor.current()
+	currentInputParam.hasArgument = i.cursor.hasCurrent()
+
+	for _, resolver := range i.resolvers {
+		if resolved, ok := i.apply(resolver, currentInputParam, param); ok {
+			return resolved, true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func (i *invocation) apply(resolver ParamResolver, currentInputParam *Reflection, param any) (reflect.Value, bool) {
+	resolved, paramUsed := resolver(currentInputParam, param)
+	if resolved == nil {
+		return reflect.Value{}, false
+	}
+
+	if paramUsed {
+		i.cursor.advance()
+	}
+
+	return reflect.ValueOf(resolved), true
+}
+
+type argumentCursor struct {
+	arguments []any
+	position  int
+}
+
+func newArgumentCursor(arguments []any) *argumentCursor {
+	return &argumentCursor{arguments: arguments}
+}
+
+func (c *argumentCursor) current() any {
+	if !c.hasCurrent() {
+		return nil
+	}
+
+	return c.arguments[c.position]
+}
+
+func (c *argumentCursor) hasCurrent() bool {
+	return c.position < len(c.arguments)
+}
+
+func (c *argumentCursor) advance() {
+	if c.hasCurrent() {
+		c.position++
+	}
+}
+
+func (c *argumentCursor) remaining() []any {
+	return c.arguments[c.position:]
+}
@@ -0,0 +1,104 @@
+package reflectify
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestArgumentCursor(t *testing.T) {
+	t.Run("cursor should return arguments in order", func(t *testing.T) {
+		cursor := newArgumentCursor([]any{1, "x"})
+
+		first := cursor.current()
+		cursor.advance()
+		second := cursor.current()
+
+		if first != 1 || second != "x" {
+			t.Errorf("arguments (%v, %v) given. expected: (%v, %v)", first, second, 1, "x")
+		}
+	})
+
+	t.Run("cursor should return nil once exhausted", func(t *testing.T) {
+		cursor := newArgumentCursor([]any{1})
+
+		cursor.advance()
+		cursor.advance()
+
+		if cursor.hasCurrent() || cursor.current() != nil {
+			t.Errorf("cursor should be exhausted")
+		}
+	})
+}
+
+func TestConcurrentCall(t *testing.T) {
+	t.Run("one reflection should be callable from many goroutines", func(t *testing.T) {
+		refl := Reflect(func(testStruct *TestStruct, a int, b string) string {
+			return testStruct.Field1 + b
+		})
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			if definition.InstanceOf(&TestStruct{}) {
+				ts := definition.New().(*TestStruct)
+				ts.Field1 = "prefix-"
+
+				return ts, false
+			}
+
+			return nil, false
+		})
+
+		var wg sync.WaitGroup
+		errs := make(chan string, 100)
+
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				result := refl.Call(i, "x")
+				if result[0].String() != "prefix-x" {
+					errs <- result[0].String()
+				}
+
+				if _, err := refl.CallE(i, "x"); err != nil {
+					errs <- err.Error()
+				}
+			}(i)
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			t.Errorf("unexpected result: %s", err)
+		}
+	})
+
+	t.Run("adding resolvers while calling should be safe", func(t *testing.T) {
+		refl := Reflect(TestStruct{})
+		method := refl.MethodByName("TestWithScalarParam")
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+
+				refl.CallMethod("TestWithScalarParam", "x")
+				method.Call(TestStruct{}, "x")
+			}()
+			go func() {
+				defer wg.Done()
+
+				method.AddResolver(func(definition *Reflection, param any) (any, bool) {
+					return nil, false
+				})
+			}()
+		}
+
+		wg.Wait()
+
+		if len(method.resolvers) != 50 {
+			t.Errorf("current len of %d does not match expected %d", len(method.resolvers), 50)
+		}
+	})
+}
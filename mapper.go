@@ -1,58 +1,810 @@
 package reflectify
 
 import (
+	"fmt"
+	"math"
+	"reflect"
 	"strconv"
+	"strings"
 )
 
 func NewMapper(value any) *Mapper {
 	return &Mapper{value: value}
 }
 
+func NewStrictMapper(value any) *Mapper {
+	return &Mapper{value: value, mappingOptions: mappingOptions{strict: true}}
+}
+
 type Mapper struct {
 	value any
+	mappingOptions
+}
+
+type mappingOptions struct {
+	strict     bool
+	converters *converterRegistry
+	separators []string
+	multiValue MultiValueMode
+}
+
+type MultiValueMode int
+
+const (
+	FirstValue MultiValueMode = iota
+	AllValues
+)
+
+var defaultSeparators = []string{","}
+
+func (m *Mapper) WithSeparators(separators ...string) *Mapper {
+	m.separators = separators
+
+	return m
+}
+
+func (m *Mapper) WithMultiValueMode(mode MultiValueMode) *Mapper {
+	m.multiValue = mode
+
+	return m
+}
+
+func (m *Mapper) with(value any) *Mapper {
+	return &Mapper{value: value, mappingOptions: m.mappingOptions}
 }
 
 func (m *Mapper) String() string {
-	switch m.value.(type) {
-	case int:
-		return strconv.Itoa(m.value.(int))
-	case bool:
-		return strconv.FormatBool(m.value.(bool))
+	result, _ := m.StringE()
+
+	return result
+}
+
+func (m *Mapper) StringE() (string, error) {
+	return convertMapper[string](m)
+}
+
+func (m *Mapper) Int() int {
+	result, _ := m.IntE()
+
+	return result
+}
+
+func (m *Mapper) IntE() (int, error) {
+	return convertMapper[int](m)
+}
+
+func (m *Mapper) Int8() int8 {
+	result, _ := m.Int8E()
+
+	return result
+}
+
+func (m *Mapper) Int8E() (int8, error) {
+	return convertMapper[int8](m)
+}
+
+func (m *Mapper) Int16() int16 {
+	result, _ := m.Int16E()
+
+	return result
+}
+
+func (m *Mapper) Int16E() (int16, error) {
+	return convertMapper[int16](m)
+}
+
+func (m *Mapper) Int32() int32 {
+	result, _ := m.Int32E()
+
+	return result
+}
+
+func (m *Mapper) Int32E() (int32, error) {
+	return convertMapper[int32](m)
+}
+
+func (m *Mapper) Int64() int64 {
+	result, _ := m.Int64E()
+
+	return result
+}
+
+func (m *Mapper) Int64E() (int64, error) {
+	return convertMapper[int64](m)
+}
+
+func (m *Mapper) Uint() uint {
+	result, _ := m.UintE()
+
+	return result
+}
+
+func (m *Mapper) UintE() (uint, error) {
+	return convertMapper[uint](m)
+}
+
+func (m *Mapper) Uint8() uint8 {
+	result, _ := m.Uint8E()
+
+	return result
+}
+
+func (m *Mapper) Uint8E() (uint8, error) {
+	return convertMapper[uint8](m)
+}
+
+func (m *Mapper) Uint16() uint16 {
+	result, _ := m.Uint16E()
+
+	return result
+}
+
+func (m *Mapper) Uint16E() (uint16, error) {
+	return convertMapper[uint16](m)
+}
+
+func (m *Mapper) Uint32() uint32 {
+	result, _ := m.Uint32E()
+
+	return result
+}
+
+func (m *Mapper) Uint32E() (uint32, error) {
+	return convertMapper[uint32](m)
+}
+
+func (m *Mapper) Uint64() uint64 {
+	result, _ := m.Uint64E()
+
+	return result
+}
+
+func (m *Mapper) Uint64E() (uint64, error) {
+	return convertMapper[uint64](m)
+}
+
+func (m *Mapper) Float32() float32 {
+	result, _ := m.Float32E()
+
+	return result
+}
+
+func (m *Mapper) Float32E() (float32, error) {
+	return convertMapper[float32](m)
+}
+
+func (m *Mapper) Float64() float64 {
+	result, _ := m.Float64E()
+
+	return result
+}
+
+func (m *Mapper) Float64E() (float64, error) {
+	return convertMapper[float64](m)
+}
+
+func (m *Mapper) Complex64() complex64 {
+	result, _ := m.Complex64E()
+
+	return result
+}
+
+func (m *Mapper) Complex64E() (complex64, error) {
+	return convertMapper[complex64](m)
+}
+
+func (m *Mapper) Complex128() complex128 {
+	result, _ := m.Complex128E()
+
+	return result
+}
+
+func (m *Mapper) Complex128E() (complex128, error) {
+	return convertMapper[complex128](m)
+}
+
+func (m *Mapper) Bool() bool {
+	result, _ := m.BoolE()
+
+	return result
+}
+
+func (m *Mapper) BoolE() (bool, error) {
+	return convertMapper[bool](m)
+}
+
+func convertMapper[T any](m *Mapper) (T, error) {
+	var result T
+
+	converted, err := m.Convert(reflect.TypeOf(result))
+	if err != nil {
+		return result, err
+	}
+
+	reflect.ValueOf(&result).Elem().Set(reflect.ValueOf(converted))
+
+	return result, nil
+}
+
+func (m *Mapper) Convert(t reflect.Type) (any, error) {
+	if m.value == nil {
+		return reflect.Zero(t).Interface(), nil
+	}
+
+	from := reflect.TypeOf(m.value)
+	if from.AssignableTo(t) {
+		return m.value, nil
+	}
+
+	if fn, ok := lookupConverter(from, t, m.converters); ok {
+		converted, err := fn(m.value)
+		if err != nil {
+			return nil, m.conversionError(t, err)
+		}
+
+		if !convertedTo(converted, t) {
+			return nil, m.conversionError(t, ErrTypeMismatch)
+		}
+
+		return converted, nil
+	}
+
+	if from.Kind() == reflect.Ptr {
+		if reflect.ValueOf(m.value).IsNil() {
+			return m.with(nil).Convert(t)
+		}
+
+		return m.with(reflect.ValueOf(m.value).Elem().Interface()).Convert(t)
+	}
+
+	switch t {
+	case timeType:
+		return m.TimeE()
+	case durationType:
+		return m.DurationE()
+	}
+
+	if converted, ok, err := unmarshalText(m.value, t); ok {
+		if err != nil {
+			return nil, m.conversionError(t, err)
+		}
+
+		return converted, nil
+	}
+
+	if converted, ok := stringify(m.value, t); ok {
+		return converted, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return nil, m.conversionError(t, ErrUnsupportedConversion)
+	case reflect.Slice, reflect.Array:
+		return m.toSlice(t)
+	case reflect.Map:
+		if reflect.TypeOf(m.value).Kind() == reflect.Map {
+			return m.toMap(t)
+		}
+
+		fallthrough
+	case reflect.Struct:
+		destination := reflect.New(t)
+		if _, err := Reflect(destination.Interface()).fill(m.value); err != nil {
+			return nil, m.conversionError(t, err)
+		}
+
+		return destination.Elem().Interface(), nil
+	case reflect.Ptr:
+		converted, err := m.Convert(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		destination := reflect.New(t.Elem())
+		if converted != nil {
+			destination.Elem().Set(reflect.ValueOf(converted))
+		}
+
+		return destination.Interface(), nil
 	default:
-		return m.value.(string)
+		return m.scalar(t)
 	}
 }
 
-func (m *Mapper) Int() int {
-	switch m.value.(type) {
-	case bool:
-		tmp := m.value.(bool)
-		if tmp {
-			return 1
+func convertedTo(converted any, t reflect.Type) bool {
+	if converted == nil {
+		return isNillable(t)
+	}
+
+	return reflect.TypeOf(converted).AssignableTo(t)
+}
+
+func (m *Mapper) toSlice(t reflect.Type) (any, error) {
+	v := reflect.ValueOf(m.value)
+
+	var elements []any
+	switch v.Kind() {
+	case reflect.String:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return reflect.ValueOf([]byte(v.String())).Convert(t).Interface(), nil
+		}
+
+		elements = m.split(v.String())
+	case reflect.Slice, reflect.Array:
+		elements = make([]any, v.Len())
+		for i := range elements {
+			elements[i] = v.Index(i).Interface()
+		}
+	default:
+		elements = []any{m.value}
+	}
+
+	var result reflect.Value
+	if t.Kind() == reflect.Array {
+		if len(elements) > t.Len() {
+			return nil, m.conversionError(t, ErrOverflow)
+		}
+
+		result = reflect.New(t).Elem()
+	} else {
+		result = reflect.MakeSlice(t, len(elements), len(elements))
+	}
+
+	for i, element := range elements {
+		converted, err := m.with(element).Convert(t.Elem())
+		if err != nil {
+			return nil, m.conversionError(t, fmt.Errorf("index %d: %w", i, err))
+		}
+
+		if converted != nil {
+			result.Index(i).Set(reflect.ValueOf(converted))
+		}
+	}
+
+	return result.Interface(), nil
+}
+
+func (m *Mapper) toMap(t reflect.Type) (any, error) {
+	v := reflect.ValueOf(m.value)
+	result := reflect.MakeMapWithSize(t, v.Len())
+
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := m.with(iter.Key().Interface()).Convert(t.Key())
+		if err != nil {
+			return nil, m.conversionError(t, fmt.Errorf("key %v: %w", iter.Key(), err))
 		}
 
-		return 0
-	case string:
-		result, err := strconv.Atoi(m.value.(string))
+		value, err := m.flatten(iter.Value().Interface(), t.Elem())
+		if err == nil {
+			value, err = m.with(value).Convert(t.Elem())
+		}
 		if err != nil {
-			return 0
+			return nil, m.conversionError(t, fmt.Errorf("key %v: %w", iter.Key(), err))
+		}
+
+		convertedKey := reflect.Zero(t.Key())
+		if key != nil {
+			convertedKey = reflect.ValueOf(key)
+		}
+
+		convertedValue := reflect.Zero(t.Elem())
+		if value != nil {
+			convertedValue = reflect.ValueOf(value)
+		}
+
+		result.SetMapIndex(convertedKey, convertedValue)
+	}
+
+	return result.Interface(), nil
+}
+
+func (m *Mapper) flatten(value any, t reflect.Type) (any, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+		return value, nil
+	}
+
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array || (v.Type().AssignableTo(t) && m.multiValue == AllValues) {
+		return value, nil
+	}
+
+	if m.multiValue == FirstValue {
+		if v.Len() == 0 {
+			return nil, nil
+		}
+
+		return v.Index(0).Interface(), nil
+	}
+
+	if t.Kind() == reflect.String {
+		separators := m.separators
+		if len(separators) == 0 {
+			separators = defaultSeparators
 		}
 
-		return result
+		parts := make([]string, v.Len())
+		for i := range parts {
+			converted, err := m.with(v.Index(i).Interface()).StringE()
+			if err != nil {
+				return nil, err
+			}
+
+			parts[i] = converted
+		}
+
+		return strings.Join(parts, separators[0]), nil
+	}
+
+	if v.Len() == 1 {
+		return v.Index(0).Interface(), nil
+	}
+
+	return nil, ErrLossyConversion
+}
+
+func (m *Mapper) split(s string) []any {
+	separators := m.separators
+	if len(separators) == 0 {
+		separators = defaultSeparators
+	}
+
+	if strings.TrimSpace(s) == "" {
+		return []any{}
+	}
+
+	for _, separator := range separators[1:] {
+		s = strings.ReplaceAll(s, separator, separators[0])
+	}
+
+	parts := strings.Split(s, separators[0])
+	elements := make([]any, len(parts))
+	for i, part := range parts {
+		elements[i] = strings.TrimSpace(part)
+	}
+
+	return elements
+}
+
+func (m *Mapper) conversionError(to reflect.Type, err error) error {
+	return &ConversionError{From: reflect.TypeOf(m.value), To: to, Value: m.value, Err: err}
+}
+
+func (m *Mapper) scalar(t reflect.Type) (any, error) {
+	var result any
+	var err error
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result, err = m.toInt(t.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		result, err = m.toUint(t.Bits())
+	case reflect.Float32, reflect.Float64:
+		result, err = m.toFloat(t.Bits())
+	case reflect.Complex64, reflect.Complex128:
+		result, err = m.toComplex(t.Bits())
+	case reflect.Bool:
+		result, err = m.toBool()
+	case reflect.String:
+		result, err = m.toString()
 	default:
-		return m.value.(int)
+		err = ErrUnsupportedConversion
 	}
+
+	if err != nil {
+		return nil, m.conversionError(t, err)
+	}
+
+	return reflect.ValueOf(result).Convert(t).Interface(), nil
 }
 
-func (m *Mapper) Bool() bool {
-	switch m.value.(type) {
-	case bool:
-		return m.value.(bool)
-	case int:
-		return m.value.(int) > 0
-	case string:
-		return m.value.(string) != ""
+func (m *Mapper) toString() (string, error) {
+	v := reflect.ValueOf(m.value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes()), nil
+		}
+
+		return "", ErrUnsupportedConversion
+	default:
+		return "", ErrUnsupportedConversion
+	}
+}
+
+func (m *Mapper) toBool() (bool, error) {
+	v := reflect.ValueOf(m.value)
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return m.numberToBool(v.Int() > 0)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return m.numberToBool(v.Uint() > 0)
+	case reflect.Float32, reflect.Float64:
+		return m.numberToBool(v.Float() > 0)
+	case reflect.String:
+		result, err := strconv.ParseBool(strings.TrimSpace(v.String()))
+		if err == nil {
+			return result, nil
+		}
+		if m.strict {
+			return false, err
+		}
+
+		return v.String() != "", nil
+	default:
+		return false, ErrUnsupportedConversion
+	}
+}
+
+func (m *Mapper) numberToBool(positive bool) (bool, error) {
+	if m.strict {
+		return false, ErrLossyConversion
+	}
+
+	return positive, nil
+}
+
+func (m *Mapper) toInt(bits int) (int64, error) {
+	v := reflect.ValueOf(m.value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return checkIntRange(v.Int(), bits)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return 0, ErrOverflow
+		}
+
+		return checkIntRange(int64(v.Uint()), bits)
+	case reflect.Float32, reflect.Float64:
+		return m.floatToInt(v.Float(), bits)
+	case reflect.Complex64, reflect.Complex128:
+		if imag(v.Complex()) != 0 {
+			return 0, ErrLossyConversion
+		}
+
+		return m.floatToInt(real(v.Complex()), bits)
+	case reflect.Bool:
+		return m.boolToNumber(v.Bool())
+	case reflect.String:
+		s := strings.TrimSpace(v.String())
+
+		result, err := strconv.ParseInt(s, integerBase(s), bits)
+		if err == nil {
+			return result, nil
+		}
+		if isRangeError(err) {
+			return 0, ErrOverflow
+		}
+
+		f, floatErr := strconv.ParseFloat(s, 64)
+		if floatErr != nil {
+			return 0, err
+		}
+
+		return m.floatToInt(f, bits)
 	default:
-		return false
+		return 0, ErrUnsupportedConversion
 	}
 }
+
+func (m *Mapper) toUint(bits int) (uint64, error) {
+	v := reflect.ValueOf(m.value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			return 0, ErrOverflow
+		}
+
+		return checkUintRange(uint64(v.Int()), bits)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return checkUintRange(v.Uint(), bits)
+	case reflect.Float32, reflect.Float64:
+		return m.floatToUint(v.Float(), bits)
+	case reflect.Complex64, reflect.Complex128:
+		if imag(v.Complex()) != 0 {
+			return 0, ErrLossyConversion
+		}
+
+		return m.floatToUint(real(v.Complex()), bits)
+	case reflect.Bool:
+		result, err := m.boolToNumber(v.Bool())
+
+		return uint64(result), err
+	case reflect.String:
+		s := strings.TrimSpace(v.String())
+
+		result, err := strconv.ParseUint(s, integerBase(s), bits)
+		if err == nil {
+			return result, nil
+		}
+		if isRangeError(err) {
+			return 0, ErrOverflow
+		}
+
+		f, floatErr := strconv.ParseFloat(s, 64)
+		if floatErr != nil {
+			return 0, err
+		}
+
+		return m.floatToUint(f, bits)
+	default:
+		return 0, ErrUnsupportedConversion
+	}
+}
+
+func (m *Mapper) toFloat(bits int) (float64, error) {
+	v := reflect.ValueOf(m.value)
+
+	var result float64
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		result = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		result = v.Float()
+	case reflect.Complex64, reflect.Complex128:
+		if imag(v.Complex()) != 0 {
+			return 0, ErrLossyConversion
+		}
+
+		result = real(v.Complex())
+	case reflect.Bool:
+		converted, err := m.boolToNumber(v.Bool())
+		if err != nil {
+			return 0, err
+		}
+
+		result = float64(converted)
+	case reflect.String:
+		s := strings.TrimSpace(v.String())
+
+		parsed, err := strconv.ParseFloat(s, bits)
+		if err != nil {
+			if isRangeError(err) {
+				return 0, ErrOverflow
+			}
+
+			i, intErr := strconv.ParseInt(s, integerBase(s), 64)
+			if intErr != nil {
+				return 0, err
+			}
+
+			parsed = float64(i)
+		}
+
+		result = parsed
+	default:
+		return 0, ErrUnsupportedConversion
+	}
+
+	if bits == 32 && math.Abs(result) > math.MaxFloat32 && !math.IsInf(result, 0) {
+		return 0, ErrOverflow
+	}
+
+	return result, nil
+}
+
+func (m *Mapper) toComplex(bits int) (complex128, error) {
+	v := reflect.ValueOf(m.value)
+
+	switch v.Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		if bits == 64 && (math.Abs(real(c)) > math.MaxFloat32 || math.Abs(imag(c)) > math.MaxFloat32) {
+			return 0, ErrOverflow
+		}
+
+		return c, nil
+	case reflect.String:
+		c, err := strconv.ParseComplex(strings.TrimSpace(v.String()), bits)
+		if err != nil {
+			if isRangeError(err) {
+				return 0, ErrOverflow
+			}
+
+			return 0, err
+		}
+
+		return c, nil
+	default:
+		f, err := m.toFloat(bits / 2)
+		if err != nil {
+			return 0, err
+		}
+
+		return complex(f, 0), nil
+	}
+}
+
+func checkIntRange(i int64, bits int) (int64, error) {
+	if bits < 64 {
+		min := int64(-1) << (bits - 1)
+		max := -min - 1
+
+		if i < min || i > max {
+			return 0, ErrOverflow
+		}
+	}
+
+	return i, nil
+}
+
+func checkUintRange(u uint64, bits int) (uint64, error) {
+	if bits < 64 && u > uint64(1)<<bits-1 {
+		return 0, ErrOverflow
+	}
+
+	return u, nil
+}
+
+func (m *Mapper) floatToInt(f float64, bits int) (int64, error) {
+	limit := math.Ldexp(1, bits-1)
+	if math.IsNaN(f) || f < -limit || f >= limit {
+		return 0, ErrOverflow
+	}
+	if m.strict && f != math.Trunc(f) {
+		return 0, ErrLossyConversion
+	}
+
+	return int64(f), nil
+}
+
+func (m *Mapper) floatToUint(f float64, bits int) (uint64, error) {
+	if math.IsNaN(f) || f < 0 || f >= math.Ldexp(1, bits) {
+		return 0, ErrOverflow
+	}
+	if m.strict && f != math.Trunc(f) {
+		return 0, ErrLossyConversion
+	}
+
+	return uint64(f), nil
+}
+
+func (m *Mapper) boolToNumber(b bool) (int64, error) {
+	if m.strict {
+		return 0, ErrLossyConversion
+	}
+	if b {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func isRangeError(err error) bool {
+	numErr, ok := err.(*strconv.NumError)
+
+	return ok && numErr.Err == strconv.ErrRange
+}
+
+func integerBase(s string) int {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+
+	if len(s) > 1 && s[0] == '0' && !strings.ContainsRune("xXoObB", rune(s[1])) {
+		return 10
+	}
+
+	return 0
+}
@@ -1,6 +1,11 @@
 package reflectify
 
-import "testing"
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+)
 
 func TestMapToString(t *testing.T) {
 	t.Run("map int to string", func(t *testing.T) {
@@ -127,3 +132,657 @@ func TestMapToBool(t *testing.T) {
 		}
 	})
 }
+
+func TestMapNumericToString(t *testing.T) {
+	t.Run("map int64 to string", func(t *testing.T) {
+		mapper := NewMapper(int64(5))
+
+		result := mapper.String()
+
+		if result != "5" {
+			t.Errorf("could not map int64 '%v' to string '%s'. Got: %v", 5, "5", result)
+		}
+	})
+
+	t.Run("map uint8 to string", func(t *testing.T) {
+		mapper := NewMapper(uint8(5))
+
+		result := mapper.String()
+
+		if result != "5" {
+			t.Errorf("could not map uint8 '%v' to string '%s'. Got: %v", 5, "5", result)
+		}
+	})
+
+	t.Run("map float to string", func(t *testing.T) {
+		mapper := NewMapper(1.5)
+
+		result := mapper.String()
+
+		if result != "1.5" {
+			t.Errorf("could not map float '%v' to string '%s'. Got: %v", 1.5, "1.5", result)
+		}
+	})
+
+	t.Run("map float32 to string", func(t *testing.T) {
+		mapper := NewMapper(float32(0.1))
+
+		result := mapper.String()
+
+		if result != "0.1" {
+			t.Errorf("could not map float32 '%v' to string '%s'. Got: %v", 0.1, "0.1", result)
+		}
+	})
+
+	t.Run("map complex to string", func(t *testing.T) {
+		mapper := NewMapper(complex(1, 2))
+
+		result := mapper.String()
+
+		if result != "(1+2i)" {
+			t.Errorf("could not map complex '%v' to string '%s'. Got: %v", complex(1, 2), "(1+2i)", result)
+		}
+	})
+}
+
+func TestMapToSizedInt(t *testing.T) {
+	t.Run("map int64 to int", func(t *testing.T) {
+		mapper := NewMapper(int64(5))
+
+		result := mapper.Int()
+
+		if result != 5 {
+			t.Errorf("could not map int64 '%v' to int '%d'. Got: %v", 5, 5, result)
+		}
+	})
+
+	t.Run("map uint to int8", func(t *testing.T) {
+		mapper := NewMapper(uint(100))
+
+		result := mapper.Int8()
+
+		if result != 100 {
+			t.Errorf("could not map uint '%v' to int8 '%d'. Got: %v", 100, 100, result)
+		}
+	})
+
+	t.Run("map overflowing int to int8", func(t *testing.T) {
+		mapper := NewMapper(300)
+
+		result := mapper.Int8()
+
+		if result != 0 {
+			t.Errorf("overflowing value should map to zero. Got: %v", result)
+		}
+	})
+
+	t.Run("map float to int16", func(t *testing.T) {
+		mapper := NewMapper(12.7)
+
+		result := mapper.Int16()
+
+		if result != 12 {
+			t.Errorf("could not map float '%v' to int16 '%d'. Got: %v", 12.7, 12, result)
+		}
+	})
+
+	t.Run("map hex string to int32", func(t *testing.T) {
+		mapper := NewMapper("0x1F")
+
+		result := mapper.Int32()
+
+		if result != 31 {
+			t.Errorf("could not map string '%v' to int32 '%d'. Got: %v", "0x1F", 31, result)
+		}
+	})
+
+	t.Run("map octal string to int64", func(t *testing.T) {
+		mapper := NewMapper("0o17")
+
+		result := mapper.Int64()
+
+		if result != 15 {
+			t.Errorf("could not map string '%v' to int64 '%d'. Got: %v", "0o17", 15, result)
+		}
+	})
+
+	t.Run("map underscore string to int64", func(t *testing.T) {
+		mapper := NewMapper("1_000_000")
+
+		result := mapper.Int64()
+
+		if result != 1000000 {
+			t.Errorf("could not map string '%v' to int64 '%d'. Got: %v", "1_000_000", 1000000, result)
+		}
+	})
+
+	t.Run("map leading zero string to int64 as decimal", func(t *testing.T) {
+		mapper := NewMapper("010")
+
+		result := mapper.Int64()
+
+		if result != 10 {
+			t.Errorf("could not map string '%v' to int64 '%d'. Got: %v", "010", 10, result)
+		}
+	})
+
+	t.Run("map leading zero string with non octal digit to int", func(t *testing.T) {
+		mapper := NewMapper("08")
+
+		result, err := mapper.IntE()
+
+		if err != nil || result != 8 {
+			t.Errorf("could not map string '%v' to int '%d'. Got: %v. error: %v", "08", 8, result, err)
+		}
+	})
+
+	t.Run("map overflowing string to int16", func(t *testing.T) {
+		mapper := NewMapper("40000")
+
+		result := mapper.Int16()
+
+		if result != 0 {
+			t.Errorf("overflowing value should map to zero. Got: %v", result)
+		}
+	})
+}
+
+func TestMapToUint(t *testing.T) {
+	t.Run("map int to uint", func(t *testing.T) {
+		mapper := NewMapper(5)
+
+		result := mapper.Uint()
+
+		if result != 5 {
+			t.Errorf("could not map int '%v' to uint '%d'. Got: %v", 5, 5, result)
+		}
+	})
+
+	t.Run("map negative int to uint", func(t *testing.T) {
+		mapper := NewMapper(-1)
+
+		result := mapper.Uint64()
+
+		if result != 0 {
+			t.Errorf("negative value should map to zero. Got: %v", result)
+		}
+	})
+
+	t.Run("map overflowing int to uint8", func(t *testing.T) {
+		mapper := NewMapper(256)
+
+		result := mapper.Uint8()
+
+		if result != 0 {
+			t.Errorf("overflowing value should map to zero. Got: %v", result)
+		}
+	})
+
+	t.Run("map bool to uint16", func(t *testing.T) {
+		mapper := NewMapper(true)
+
+		result := mapper.Uint16()
+
+		if result != 1 {
+			t.Errorf("could not map bool '%v' to uint16 '%d'. Got: %v", true, 1, result)
+		}
+	})
+
+	t.Run("map leading zero string to uint64 as decimal", func(t *testing.T) {
+		mapper := NewMapper("010")
+
+		result := mapper.Uint64()
+
+		if result != 10 {
+			t.Errorf("could not map string '%v' to uint64 '%d'. Got: %v", "010", 10, result)
+		}
+	})
+
+	t.Run("map binary string to uint32", func(t *testing.T) {
+		mapper := NewMapper("0b101")
+
+		result := mapper.Uint32()
+
+		if result != 5 {
+			t.Errorf("could not map string '%v' to uint32 '%d'. Got: %v", "0b101", 5, result)
+		}
+	})
+}
+
+func TestMapToFloat(t *testing.T) {
+	t.Run("map int to float64", func(t *testing.T) {
+		mapper := NewMapper(2)
+
+		result := mapper.Float64()
+
+		if result != 2 {
+			t.Errorf("could not map int '%v' to float64 '%v'. Got: %v", 2, 2.0, result)
+		}
+	})
+
+	t.Run("map string to float32", func(t *testing.T) {
+		mapper := NewMapper("1.5")
+
+		result := mapper.Float32()
+
+		if result != 1.5 {
+			t.Errorf("could not map string '%v' to float32 '%v'. Got: %v", "1.5", 1.5, result)
+		}
+	})
+
+	t.Run("map hex string to float64", func(t *testing.T) {
+		mapper := NewMapper("0xff")
+
+		result := mapper.Float64()
+
+		if result != 255 {
+			t.Errorf("could not map string '%v' to float64 '%v'. Got: %v", "0xff", 255.0, result)
+		}
+	})
+
+	t.Run("map overflowing float64 to float32", func(t *testing.T) {
+		mapper := NewMapper(1e300)
+
+		result := mapper.Float32()
+
+		if result != 0 {
+			t.Errorf("overflowing value should map to zero. Got: %v", result)
+		}
+	})
+}
+
+func TestMapToComplex(t *testing.T) {
+	t.Run("map int to complex128", func(t *testing.T) {
+		mapper := NewMapper(2)
+
+		result := mapper.Complex128()
+
+		if result != complex(2, 0) {
+			t.Errorf("could not map int '%v' to complex128 '%v'. Got: %v", 2, complex(2, 0), result)
+		}
+	})
+
+	t.Run("map string to complex64", func(t *testing.T) {
+		mapper := NewMapper("1+2i")
+
+		result := mapper.Complex64()
+
+		if result != complex64(complex(1, 2)) {
+			t.Errorf("could not map string '%v' to complex64 '%v'. Got: %v", "1+2i", complex(1, 2), result)
+		}
+	})
+}
+
+func TestMapWithError(t *testing.T) {
+	t.Run("int e should return conversion error for none numeric string", func(t *testing.T) {
+		mapper := NewMapper("test")
+
+		_, err := mapper.IntE()
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Fatalf("expected conversion error. %v given", err)
+		}
+		if convErr.From != reflect.TypeOf("") || convErr.To != reflect.TypeOf(0) || convErr.Value != "test" {
+			t.Errorf("conversion error holds wrong details: %+v", convErr)
+		}
+	})
+
+	t.Run("int8 e should return overflow error", func(t *testing.T) {
+		mapper := NewMapper(300)
+
+		_, err := mapper.Int8E()
+
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("expected overflow error. %v given", err)
+		}
+	})
+
+	t.Run("string e should return error for struct", func(t *testing.T) {
+		mapper := NewMapper(struct{}{})
+
+		result, err := mapper.StringE()
+
+		if !errors.Is(err, ErrUnsupportedConversion) {
+			t.Errorf("expected unsupported conversion error. %v given", err)
+		}
+		if result != "" {
+			t.Errorf("result should be empty. Got: %v", result)
+		}
+	})
+
+	t.Run("string should return empty string for struct", func(t *testing.T) {
+		mapper := NewMapper(struct{}{})
+
+		result := mapper.String()
+
+		if result != "" {
+			t.Errorf("result should be empty. Got: %v", result)
+		}
+	})
+
+	t.Run("bool e should return error for struct", func(t *testing.T) {
+		mapper := NewMapper(struct{}{})
+
+		_, err := mapper.BoolE()
+
+		if !errors.Is(err, ErrUnsupportedConversion) {
+			t.Errorf("expected unsupported conversion error. %v given", err)
+		}
+	})
+
+	t.Run("bool e should parse bool strings", func(t *testing.T) {
+		mapper := NewMapper("false")
+
+		result, err := mapper.BoolE()
+
+		if err != nil || result {
+			t.Errorf("could not map string '%v' to bool '%v'. Got: %v, %v", "false", false, result, err)
+		}
+	})
+
+	t.Run("float64 e should return value without error", func(t *testing.T) {
+		mapper := NewMapper("1.5")
+
+		result, err := mapper.Float64E()
+
+		if err != nil || result != 1.5 {
+			t.Errorf("could not map string '%v' to float64 '%v'. Got: %v, %v", "1.5", 1.5, result, err)
+		}
+	})
+}
+
+func TestStrictMapper(t *testing.T) {
+	t.Run("strict mapper should refuse fractional floats for ints", func(t *testing.T) {
+		mapper := NewStrictMapper(1.5)
+
+		_, err := mapper.IntE()
+
+		if !errors.Is(err, ErrLossyConversion) {
+			t.Errorf("expected lossy conversion error. %v given", err)
+		}
+	})
+
+	t.Run("strict mapper should accept whole floats for ints", func(t *testing.T) {
+		mapper := NewStrictMapper(2.0)
+
+		result, err := mapper.IntE()
+
+		if err != nil || result != 2 {
+			t.Errorf("could not map float '%v' to int '%d'. Got: %v, %v", 2.0, 2, result, err)
+		}
+	})
+
+	t.Run("strict mapper should refuse bools for numbers", func(t *testing.T) {
+		mapper := NewStrictMapper(true)
+
+		_, err := mapper.Uint8E()
+
+		if !errors.Is(err, ErrLossyConversion) {
+			t.Errorf("expected lossy conversion error. %v given", err)
+		}
+	})
+
+	t.Run("strict mapper should refuse numbers for bools", func(t *testing.T) {
+		mapper := NewStrictMapper(2)
+
+		_, err := mapper.BoolE()
+
+		if !errors.Is(err, ErrLossyConversion) {
+			t.Errorf("expected lossy conversion error. %v given", err)
+		}
+	})
+
+	t.Run("strict mapper should refuse none bool strings for bools", func(t *testing.T) {
+		mapper := NewStrictMapper("yes please")
+
+		_, err := mapper.BoolE()
+
+		if err == nil {
+			t.Errorf("expected error for none bool string")
+		}
+	})
+
+	t.Run("weak mapper should truncate fractional floats", func(t *testing.T) {
+		mapper := NewMapper(1.5)
+
+		result, err := mapper.IntE()
+
+		if err != nil || result != 1 {
+			t.Errorf("could not map float '%v' to int '%d'. Got: %v, %v", 1.5, 1, result, err)
+		}
+	})
+}
+
+func TestMapToSlice(t *testing.T) {
+	intsType := reflect.TypeOf([]int{})
+
+	t.Run("map separated string to slice", func(t *testing.T) {
+		mapper := NewMapper("1, 2,3")
+
+		result, err := mapper.Convert(intsType)
+
+		if err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("could not map string to slice. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map string with custom separators to slice", func(t *testing.T) {
+		mapper := NewMapper("1;2|3").WithSeparators(";", "|")
+
+		result, err := mapper.Convert(intsType)
+
+		if err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("could not map string to slice. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map empty string to empty slice", func(t *testing.T) {
+		mapper := NewMapper("")
+
+		result, err := mapper.Convert(intsType)
+
+		if err != nil || !reflect.DeepEqual(result, []int{}) {
+			t.Errorf("could not map string to slice. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map string slice to int slice", func(t *testing.T) {
+		mapper := NewMapper([]string{"1", "2"})
+
+		result, err := mapper.Convert(intsType)
+
+		if err != nil || !reflect.DeepEqual(result, []int{1, 2}) {
+			t.Errorf("could not map slice to slice. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map mixed slice to int slice", func(t *testing.T) {
+		mapper := NewMapper([]any{1, "2", 3.0})
+
+		result, err := mapper.Convert(intsType)
+
+		if err != nil || !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Errorf("could not map slice to slice. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map single value to slice", func(t *testing.T) {
+		mapper := NewMapper(5)
+
+		result, err := mapper.Convert(reflect.TypeOf([]string{}))
+
+		if err != nil || !reflect.DeepEqual(result, []string{"5"}) {
+			t.Errorf("could not map single value to slice. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map string to byte slice", func(t *testing.T) {
+		mapper := NewMapper("abc")
+
+		result, err := mapper.Convert(reflect.TypeOf([]byte{}))
+
+		if err != nil || !reflect.DeepEqual(result, []byte("abc")) {
+			t.Errorf("could not map string to byte slice. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map slice to array", func(t *testing.T) {
+		mapper := NewMapper([]int{1, 2})
+
+		result, err := mapper.Convert(reflect.TypeOf([3]string{}))
+
+		if err != nil || !reflect.DeepEqual(result, [3]string{"1", "2", ""}) {
+			t.Errorf("could not map slice to array. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map too long slice to array should return error", func(t *testing.T) {
+		mapper := NewMapper([]int{1, 2, 3})
+
+		_, err := mapper.Convert(reflect.TypeOf([2]int{}))
+
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("expected overflow error. %v given", err)
+		}
+	})
+
+	t.Run("map slice with invalid element should return error", func(t *testing.T) {
+		mapper := NewMapper([]string{"1", "test"})
+
+		_, err := mapper.Convert(intsType)
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) || convErr.To != intsType {
+			t.Errorf("expected conversion error for slice. %v given", err)
+		}
+	})
+}
+
+func TestMapToMap(t *testing.T) {
+	t.Run("map any map to typed map", func(t *testing.T) {
+		mapper := NewMapper(map[string]any{"a": "1", "b": 2.0})
+
+		result, err := mapper.Convert(reflect.TypeOf(map[string]int{}))
+
+		if err != nil || !reflect.DeepEqual(result, map[string]int{"a": 1, "b": 2}) {
+			t.Errorf("could not map map to typed map. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map keys to desired type", func(t *testing.T) {
+		mapper := NewMapper(map[string]string{"1": "a", "2": "b"})
+
+		result, err := mapper.Convert(reflect.TypeOf(map[int]string{}))
+
+		if err != nil || !reflect.DeepEqual(result, map[int]string{1: "a", 2: "b"}) {
+			t.Errorf("could not map keys of map. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map url values to first values", func(t *testing.T) {
+		mapper := NewMapper(url.Values{"page": {"2", "3"}, "size": {"10"}})
+
+		result, err := mapper.Convert(reflect.TypeOf(map[string]int{}))
+
+		if err != nil || !reflect.DeepEqual(result, map[string]int{"page": 2, "size": 10}) {
+			t.Errorf("could not map url values. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map url values to all values", func(t *testing.T) {
+		mapper := NewMapper(url.Values{"tag": {"a", "b"}}).WithMultiValueMode(AllValues)
+
+		result, err := mapper.Convert(reflect.TypeOf(map[string]string{}))
+
+		if err != nil || !reflect.DeepEqual(result, map[string]string{"tag": "a,b"}) {
+			t.Errorf("could not map url values. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map url values to slice values", func(t *testing.T) {
+		mapper := NewMapper(url.Values{"id": {"1", "2"}})
+
+		result, err := mapper.Convert(reflect.TypeOf(map[string][]int{}))
+
+		if err != nil || !reflect.DeepEqual(result, map[string][]int{"id": {1, 2}}) {
+			t.Errorf("could not map url values. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map url values to any values", func(t *testing.T) {
+		mapper := NewMapper(url.Values{"id": {"1", "2"}}).WithMultiValueMode(AllValues)
+
+		result, err := mapper.Convert(reflect.TypeOf(map[string]any{}))
+
+		if err != nil || !reflect.DeepEqual(result, map[string]any{"id": []string{"1", "2"}}) {
+			t.Errorf("could not map url values. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map multiple values to number should return error with all values", func(t *testing.T) {
+		mapper := NewMapper(url.Values{"id": {"1", "2"}}).WithMultiValueMode(AllValues)
+
+		_, err := mapper.Convert(reflect.TypeOf(map[string]int{}))
+
+		if !errors.Is(err, ErrLossyConversion) {
+			t.Errorf("expected lossy conversion error. %v given", err)
+		}
+	})
+
+	t.Run("map invalid value should return error", func(t *testing.T) {
+		mapper := NewMapper(map[string]any{"a": "test"})
+
+		_, err := mapper.Convert(reflect.TypeOf(map[string]int{}))
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("expected conversion error. %v given", err)
+		}
+	})
+}
+
+func TestMapPointers(t *testing.T) {
+	t.Run("map pointer to value", func(t *testing.T) {
+		value := 5
+		mapper := NewMapper(&value)
+
+		result, err := mapper.Convert(reflect.TypeOf(""))
+
+		if err != nil || result != "5" {
+			t.Errorf("could not map pointer to value. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map value to nested pointer", func(t *testing.T) {
+		mapper := NewMapper("5")
+
+		result, err := mapper.Convert(reflect.TypeOf((**int)(nil)))
+
+		if err != nil || **result.(**int) != 5 {
+			t.Errorf("could not map value to nested pointer. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map nil pointer to typed nil", func(t *testing.T) {
+		var value *string
+		mapper := NewMapper(value)
+
+		result, err := mapper.Convert(reflect.TypeOf((*int)(nil)))
+
+		if err != nil || result.(*int) != nil {
+			t.Errorf("could not map nil pointer to typed nil. Got: %v, %v", result, err)
+		}
+	})
+
+	t.Run("map nil pointer to zero value", func(t *testing.T) {
+		var value *string
+		mapper := NewMapper(value)
+
+		result, err := mapper.Convert(reflect.TypeOf(0))
+
+		if err != nil || result != 0 {
+			t.Errorf("could not map nil pointer to zero value. Got: %v, %v", result, err)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package reflectify
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+var defaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+const unixMillisThreshold = 1e11
+
+func (m *Mapper) Time(layouts ...string) time.Time {
+	result, _ := m.TimeE(layouts...)
+
+	return result
+}
+
+func (m *Mapper) TimeE(layouts ...string) (time.Time, error) {
+	result, err := m.toTime(layouts)
+	if err != nil {
+		return time.Time{}, m.conversionError(timeType, err)
+	}
+
+	return result, nil
+}
+
+func (m *Mapper) Duration() time.Duration {
+	result, _ := m.DurationE()
+
+	return result
+}
+
+func (m *Mapper) DurationE() (time.Duration, error) {
+	result, err := m.toDuration()
+	if err != nil {
+		return 0, m.conversionError(durationType, err)
+	}
+
+	return result, nil
+}
+
+func (m *Mapper) toTime(layouts []string) (time.Time, error) {
+	if t, ok := m.value.(time.Time); ok {
+		return t, nil
+	}
+
+	v := reflect.ValueOf(m.value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		unix, err := m.toInt(64)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return unixTime(unix), nil
+	case reflect.String:
+		s := strings.TrimSpace(v.String())
+		if len(layouts) == 0 {
+			layouts = defaultTimeLayouts
+		}
+
+		var err error
+		for _, layout := range layouts {
+			var t time.Time
+			if t, err = time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+
+		if unix, parseErr := strconv.ParseInt(s, 10, 64); parseErr == nil {
+			return unixTime(unix), nil
+		}
+
+		return time.Time{}, err
+	default:
+		return time.Time{}, ErrUnsupportedConversion
+	}
+}
+
+func (m *Mapper) toDuration() (time.Duration, error) {
+	if d, ok := m.value.(time.Duration); ok {
+		return d, nil
+	}
+
+	v := reflect.ValueOf(m.value)
+
+	if v.Kind() == reflect.String {
+		s := strings.TrimSpace(v.String())
+
+		d, err := time.ParseDuration(s)
+		if err == nil {
+			return d, nil
+		}
+
+		if n, parseErr := strconv.ParseInt(s, 10, 64); parseErr == nil {
+			return time.Duration(n), nil
+		}
+
+		return 0, err
+	}
+
+	if v.Kind() == reflect.Bool {
+		return 0, ErrUnsupportedConversion
+	}
+
+	n, err := m.toInt(64)
+
+	return time.Duration(n), err
+}
+
+func unixTime(unix int64) time.Time {
+	if math.Abs(float64(unix)) >= unixMillisThreshold {
+		return time.UnixMilli(unix).UTC()
+	}
+
+	return time.Unix(unix, 0).UTC()
+}
@@ -0,0 +1,162 @@
+package reflectify
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMapToTime(t *testing.T) {
+	expected := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("map rfc3339 string to time", func(t *testing.T) {
+		mapper := NewMapper("2023-01-02T03:04:05Z")
+
+		result := mapper.Time()
+
+		if !result.Equal(expected) {
+			t.Errorf("could not map string to time '%v'. Got: %v", expected, result)
+		}
+	})
+
+	t.Run("map unix seconds to time", func(t *testing.T) {
+		mapper := NewMapper(expected.Unix())
+
+		result := mapper.Time()
+
+		if !result.Equal(expected) {
+			t.Errorf("could not map unix seconds to time '%v'. Got: %v", expected, result)
+		}
+	})
+
+	t.Run("map unix millis string to time", func(t *testing.T) {
+		mapper := NewMapper("1672628645000")
+
+		result := mapper.Time()
+
+		if !result.Equal(expected) {
+			t.Errorf("could not map unix millis to time '%v'. Got: %v", expected, result)
+		}
+	})
+
+	t.Run("map string with custom layout to time", func(t *testing.T) {
+		mapper := NewMapper("02.01.2023 03:04:05")
+
+		result := mapper.Time("02.01.2006 15:04:05")
+
+		if !result.Equal(expected) {
+			t.Errorf("could not map string to time '%v'. Got: %v", expected, result)
+		}
+	})
+
+	t.Run("map time to time", func(t *testing.T) {
+		mapper := NewMapper(expected)
+
+		result := mapper.Time()
+
+		if !result.Equal(expected) {
+			t.Errorf("could not map time to time '%v'. Got: %v", expected, result)
+		}
+	})
+
+	t.Run("map invalid string to time should return error", func(t *testing.T) {
+		mapper := NewMapper("yesterday")
+
+		_, err := mapper.TimeE()
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("expected conversion error. %v given", err)
+		}
+	})
+}
+
+func TestMapToDuration(t *testing.T) {
+	t.Run("map duration string to duration", func(t *testing.T) {
+		mapper := NewMapper("1m30s")
+
+		result := mapper.Duration()
+
+		if result != 90*time.Second {
+			t.Errorf("could not map string to duration '%v'. Got: %v", 90*time.Second, result)
+		}
+	})
+
+	t.Run("map int to duration", func(t *testing.T) {
+		mapper := NewMapper(int64(time.Second))
+
+		result := mapper.Duration()
+
+		if result != time.Second {
+			t.Errorf("could not map int to duration '%v'. Got: %v", time.Second, result)
+		}
+	})
+
+	t.Run("map invalid string to duration should return error", func(t *testing.T) {
+		mapper := NewMapper("soon")
+
+		_, err := mapper.DurationE()
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("expected conversion error. %v given", err)
+		}
+	})
+}
+
+func TestCallTimeParams(t *testing.T) {
+	t.Run("call should map value to time", func(t *testing.T) {
+		var tmp time.Time
+		refl := Reflect(func(param time.Time) { tmp = param })
+
+		refl.Call("2023-01-02")
+
+		if !tmp.Equal(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("current value '%v' given", tmp)
+		}
+	})
+
+	t.Run("call should use zero time for malformed input", func(t *testing.T) {
+		tmp := time.Now()
+		refl := Reflect(func(param time.Time) { tmp = param })
+
+		refl.Call("yesterday")
+
+		if !tmp.IsZero() {
+			t.Errorf("current value '%v' given. expected zero time", tmp)
+		}
+	})
+
+	t.Run("call e should report malformed time input", func(t *testing.T) {
+		refl := Reflect(func(param time.Time) {})
+
+		_, err := refl.CallE("yesterday")
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Errorf("expected conversion error. %v given", err)
+		}
+	})
+
+	t.Run("call should map value to time pointer", func(t *testing.T) {
+		var tmp *time.Time
+		refl := Reflect(func(param *time.Time) { tmp = param })
+
+		refl.Call(int64(0))
+
+		if tmp == nil || !tmp.Equal(time.Unix(0, 0)) {
+			t.Errorf("current value '%v' given", tmp)
+		}
+	})
+
+	t.Run("call should map value to duration", func(t *testing.T) {
+		var tmp time.Duration
+		refl := Reflect(func(param time.Duration) { tmp = param })
+
+		refl.Call("5s")
+
+		if tmp != 5*time.Second {
+			t.Errorf("current value '%v' given. expected: %v", tmp, 5*time.Second)
+		}
+	})
+}
@@ -0,0 +1,297 @@
+package reflectify
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+type MemberOption int
+
+const (
+	DeclaredOnly MemberOption = 1 << iota
+	IncludeShadowed
+	ValueMethods
+	PointerMethods
+	IncludeUnexported
+	AllMethods = ValueMethods | PointerMethods
+)
+
+func combineMemberOptions(options []MemberOption) MemberOption {
+	var combined MemberOption
+	for _, option := range options {
+		combined |= option
+	}
+
+	return combined
+}
+
+func (o MemberOption) has(option MemberOption) bool {
+	return o&option == option
+}
+
+type memberInfo struct {
+	declaredBy reflect.Type
+	index      []int
+	shadowed   bool
+}
+
+func (r *Reflection) DeclaredBy() *Reflection {
+	if r.member == nil {
+		return nil
+	}
+
+	return reflectType(r.member.declaredBy)
+}
+
+func (r *Reflection) EmbeddingIndex() []int {
+	if r.member == nil {
+		return nil
+	}
+
+	index := make([]int, len(r.member.index))
+	copy(index, r.member.index)
+
+	return index
+}
+
+func (r *Reflection) IsPromoted() bool {
+	return r.member != nil && len(r.member.index) > 0
+}
+
+func (r *Reflection) IsShadowed() bool {
+	return r.member != nil && r.member.shadowed
+}
+
+type structMember struct {
+	field      reflect.StructField
+	declaredBy reflect.Type
+	shadowed   bool
+}
+
+func structMembers(t reflect.Type) []structMember {
+	members := make([]structMember, 0)
+	collectStructMembers(t, nil, map[reflect.Type]bool{}, &members)
+
+	depths := make(map[string]int)
+	counts := make(map[string]int)
+	for _, member := range members {
+		depth, seen := depths[member.field.Name]
+		switch {
+		case !seen || len(member.field.Index) < depth:
+			depths[member.field.Name] = len(member.field.Index)
+			counts[member.field.Name] = 1
+		case len(member.field.Index) == depth:
+			counts[member.field.Name]++
+		}
+	}
+
+	for i, member := range members {
+		name := member.field.Name
+		members[i].shadowed = len(member.field.Index) > depths[name] || counts[name] > 1
+	}
+
+	return members
+}
+
+func collectStructMembers(t reflect.Type, index []int, visited map[reflect.Type]bool, members *[]structMember) {
+	visited[t] = true
+	defer delete(visited, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		field.Index = append(append(make([]int, 0, len(index)+1), index...), i)
+
+		*members = append(*members, structMember{field: field, declaredBy: t})
+
+		embedded := elemType(field.Type)
+		if field.Anonymous && embedded.Kind() == reflect.Struct && !visited[embedded] {
+			collectStructMembers(embedded, field.Index, visited, members)
+		}
+	}
+}
+
+type embeddedType struct {
+	t       reflect.Type
+	pointer bool
+	index   []int
+}
+
+func embeddedTypes(t reflect.Type) []embeddedType {
+	result := make([]embeddedType, 0)
+	if t.Kind() != reflect.Struct {
+		return result
+	}
+
+	visited := map[reflect.Type]bool{t: true}
+	level := []embeddedType{{t: t}}
+
+	for len(level) > 0 {
+		next := make([]embeddedType, 0)
+
+		for _, node := range level {
+			for i := 0; i < node.t.NumField(); i++ {
+				field := node.t.Field(i)
+				if !field.Anonymous {
+					continue
+				}
+
+				embedded := embeddedType{
+					t:       elemType(field.Type),
+					pointer: field.Type.Kind() == reflect.Ptr,
+					index:   append(append(make([]int, 0, len(node.index)+1), node.index...), i),
+				}
+				result = append(result, embedded)
+
+				if embedded.t.Kind() == reflect.Struct && !visited[embedded.t] {
+					visited[embedded.t] = true
+					next = append(next, embedded)
+				}
+			}
+		}
+
+		level = next
+	}
+
+	return result
+}
+
+func methodOrigin(t reflect.Type, name string) *memberInfo {
+	if declaresMethod(t, name) {
+		return &memberInfo{declaredBy: t}
+	}
+
+	for _, embedded := range embeddedTypes(t) {
+		if declaresMethod(embedded.t, name) {
+			return &memberInfo{declaredBy: embedded.t, index: embedded.index}
+		}
+	}
+
+	return &memberInfo{declaredBy: t}
+}
+
+func declaresMethod(t reflect.Type, name string) bool {
+	if t.Kind() == reflect.Interface {
+		_, found := t.MethodByName(name)
+
+		return found
+	}
+
+	for _, candidate := range []reflect.Type{t, reflect.PointerTo(t)} {
+		if method, found := candidate.MethodByName(name); found && !isAutogenerated(method.Func) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isAutogenerated(fn reflect.Value) bool {
+	pc := fn.Pointer()
+
+	f := runtime.FuncForPC(pc)
+	if f == nil {
+		return false
+	}
+
+	file, _ := f.FileLine(pc)
+
+	return file == "<autogenerated>"
+}
+
+func (r *Reflection) methodSet(option MemberOption) []reflect.Method {
+	methods := make([]reflect.Method, 0)
+
+	if !option.has(ValueMethods) && !option.has(PointerMethods) {
+		for i := 0; i < r.t.NumMethod(); i++ {
+			methods = append(methods, r.t.Method(i))
+		}
+
+		return methods
+	}
+
+	base := elemType(r.t)
+	if option.has(ValueMethods) {
+		for i := 0; i < base.NumMethod(); i++ {
+			methods = append(methods, base.Method(i))
+		}
+	}
+
+	if option.has(PointerMethods) && base.Kind() != reflect.Interface {
+		pointer := reflect.PointerTo(base)
+		for i := 0; i < pointer.NumMethod(); i++ {
+			method := pointer.Method(i)
+			if _, found := base.MethodByName(method.Name); !found {
+				methods = append(methods, method)
+			}
+		}
+	}
+
+	return methods
+}
+
+func (r *Reflection) shadowedMethods(option MemberOption) map[string]*Reflection {
+	result := make(map[string]*Reflection)
+
+	base := elemType(r.t)
+	outer := r.t
+	if option.has(PointerMethods) && base.Kind() != reflect.Interface {
+		outer = reflect.PointerTo(base)
+	}
+
+	for _, embedded := range embeddedTypes(base) {
+		if embedded.t.Kind() == reflect.Interface {
+			continue
+		}
+
+		methodSet := embedded.t
+		if embedded.pointer || outer.Kind() == reflect.Ptr {
+			methodSet = reflect.PointerTo(embedded.t)
+		}
+
+		for i := 0; i < methodSet.NumMethod(); i++ {
+			method := methodSet.Method(i)
+			if !declaresMethod(embedded.t, method.Name) {
+				continue
+			}
+
+			if _, visible := outer.MethodByName(method.Name); visible {
+				origin := methodOrigin(base, method.Name)
+				if equalIndex(origin.index, embedded.index) {
+					continue
+				}
+			}
+
+			refl := Reflect(method.Func)
+			refl.member = &memberInfo{declaredBy: embedded.t, index: embedded.index, shadowed: true}
+			result[embeddingPath(base, embedded.index)+"."+method.Name] = refl
+		}
+	}
+
+	return result
+}
+
+func embeddingPath(t reflect.Type, index []int) string {
+	names := make([]string, 0, len(index))
+	for _, i := range index {
+		field := t.Field(i)
+		names = append(names, field.Name)
+		t = elemType(field.Type)
+	}
+
+	return strings.Join(names, ".")
+}
+
+func equalIndex(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
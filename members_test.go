@@ -0,0 +1,233 @@
+package reflectify
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMemberFields(t *testing.T) {
+	t.Run("fields should report where promoted fields are declared", func(t *testing.T) {
+		field := Reflect(TestDocument{}).FieldByName("ID")
+
+		if !field.IsPromoted() || field.IsShadowed() {
+			t.Errorf("field should be promoted and not shadowed")
+		}
+		if field.DeclaredBy().Name() != "testBase" {
+			t.Errorf("declared by '%s' given. expected: '%s'", field.DeclaredBy().Name(), "testBase")
+		}
+	})
+
+	t.Run("fields should resolve shadowed fields to the shallowest one", func(t *testing.T) {
+		field := Reflect(TestDocument{}).FieldByName("Name")
+
+		if field.IsPromoted() || field.DeclaredBy().Name() != "TestDocument" {
+			t.Errorf("field should be declared by TestDocument")
+		}
+	})
+
+	t.Run("fields should include shadowed fields on request", func(t *testing.T) {
+		shadowed := make([]*Field, 0)
+		for _, field := range Reflect(TestDocument{}).Fields(IncludeShadowed) {
+			if field.IsShadowed() {
+				shadowed = append(shadowed, field)
+			}
+		}
+
+		if len(shadowed) != 1 || shadowed[0].Name() != "Name" || !reflect.DeepEqual(shadowed[0].Index(), []int{0, 1}) {
+			t.Errorf("only testBase.Name should be shadowed")
+		}
+	})
+
+	t.Run("fields should only list declared fields on request", func(t *testing.T) {
+		names := fieldNames(Reflect(&TestDocument{}).Fields(DeclaredOnly))
+
+		expected := []string{"TestAudit", "Name"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("field names %v given. expected: %v", names, expected)
+		}
+	})
+}
+
+func TestMemberMethods(t *testing.T) {
+	t.Run("methods should report where promoted methods are declared", func(t *testing.T) {
+		methods := Reflect(TestDocument{}).Methods()
+
+		describe := methods["Describe"]
+		if !describe.IsPromoted() || describe.DeclaredBy().Name() != "testBase" {
+			t.Errorf("Describe should be promoted from testBase")
+		}
+		if !reflect.DeepEqual(describe.EmbeddingIndex(), []int{0}) {
+			t.Errorf("index %v given. expected: %v", describe.EmbeddingIndex(), []int{0})
+		}
+
+		audit := methods["Audit"]
+		if !audit.IsPromoted() || audit.DeclaredBy().Name() != "TestAudit" {
+			t.Errorf("Audit should be promoted from TestAudit")
+		}
+	})
+
+	t.Run("methods should report directly declared methods", func(t *testing.T) {
+		kind := Reflect(TestDocument{}).MethodByName("Kind")
+
+		if kind.IsPromoted() || kind.IsShadowed() || kind.DeclaredBy().Name() != "TestDocument" {
+			t.Errorf("Kind should be declared by TestDocument")
+		}
+	})
+
+	t.Run("methods should only list declared methods on request", func(t *testing.T) {
+		names := methodNames(Reflect(TestDocument{}).Methods(DeclaredOnly))
+
+		expected := []string{"Kind"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("method names %v given. expected: %v", names, expected)
+		}
+	})
+
+	t.Run("methods should include shadowed methods on request", func(t *testing.T) {
+		methods := Reflect(TestDocument{}).Methods(IncludeShadowed)
+
+		names := methodNames(methods)
+		expected := []string{"Audit", "Describe", "Kind", "testBase.Kind"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("method names %v given. expected: %v", names, expected)
+		}
+
+		shadowed := methods["testBase.Kind"]
+		if !shadowed.IsShadowed() || shadowed.DeclaredBy().Name() != "testBase" {
+			t.Errorf("testBase.Kind should be shadowed")
+		}
+		if result := shadowed.Call(testBase{}); result[0].String() != "base" {
+			t.Errorf("shadowed method should be callable with embedded receiver")
+		}
+	})
+
+	t.Run("methods of pointers should include pointer receiver methods of embedded values", func(t *testing.T) {
+		touch := Reflect(&TestDocument{}).MethodByName("Touch")
+
+		if touch == nil || touch.DeclaredBy().Name() != "testBase" {
+			t.Errorf("Touch should be promoted from testBase")
+		}
+	})
+
+	t.Run("member info should be empty for none members", func(t *testing.T) {
+		refl := Reflect(testFunc)
+
+		if refl.DeclaredBy() != nil || refl.IsPromoted() || refl.IsShadowed() || refl.EmbeddingIndex() != nil {
+			t.Errorf("none member should not have member info")
+		}
+	})
+}
+
+func TestMethodSets(t *testing.T) {
+	t.Run("methods of values should not contain pointer receiver methods by default", func(t *testing.T) {
+		methods := Reflect(TestStruct{}).Methods()
+
+		if _, found := methods["TestWithPointerReceiver"]; found {
+			t.Errorf("pointer receiver method should not be listed")
+		}
+	})
+
+	t.Run("methods of values should contain pointer receiver methods on request", func(t *testing.T) {
+		names := methodNames(Reflect(TestStruct{}).Methods(PointerMethods))
+
+		expected := []string{"TestWithPointerReceiver"}
+		if !reflect.DeepEqual(names, expected) {
+			t.Errorf("method names %v given. expected: %v", names, expected)
+		}
+	})
+
+	t.Run("all methods should contain value and pointer receiver methods", func(t *testing.T) {
+		methods := Reflect(TestStruct{}).Methods(AllMethods)
+
+		if len(methods) != 4 {
+			t.Errorf("current len of %d does not match expected %d", len(methods), 4)
+		}
+	})
+
+	t.Run("value methods of pointers should only contain value receiver methods", func(t *testing.T) {
+		methods := Reflect(&TestStruct{}).Methods(ValueMethods)
+
+		if len(methods) != 3 {
+			t.Errorf("current len of %d does not match expected %d", len(methods), 3)
+		}
+	})
+
+	t.Run("method by name should find pointer receiver methods on request", func(t *testing.T) {
+		refl := Reflect(TestStruct{})
+
+		if refl.MethodByName("TestWithPointerReceiver") != nil {
+			t.Errorf("pointer receiver method should not be found by default")
+		}
+
+		method := refl.MethodByName("TestWithPointerReceiver", AllMethods)
+		if method == nil {
+			t.Fatalf("pointer receiver method should be found")
+		}
+
+		result := method.Call(TestStruct{Field1: "test"})
+		if result[0].String() != "test" {
+			t.Errorf("method should be callable with a value receiver")
+		}
+	})
+
+	t.Run("shadowed methods should respect the pointer method set", func(t *testing.T) {
+		methods := Reflect(TestDocument{}).Methods(AllMethods, IncludeShadowed)
+
+		if _, found := methods["testBase.Touch"]; found {
+			t.Errorf("visible pointer receiver method should not be shadowed")
+		}
+		if methods["Touch"] == nil {
+			t.Errorf("pointer receiver method should be listed")
+		}
+	})
+}
+
+func fieldNames(fields []*Field) []string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Name())
+	}
+
+	return names
+}
+
+func methodNames(methods map[string]*Reflection) []string {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+type testBase struct {
+	ID   int
+	Name string
+}
+
+func (testBase) Describe() string {
+	return "base"
+}
+
+func (testBase) Kind() string {
+	return "base"
+}
+
+func (*testBase) Touch() {}
+
+func (a *TestAudit) Audit() string {
+	return a.CreatedBy
+}
+
+type TestDocument struct {
+	testBase
+	*TestAudit
+	Name string
+}
+
+func (TestDocument) Kind() string {
+	return "document"
+}
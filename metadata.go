@@ -0,0 +1,122 @@
+package reflectify
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+var typeMetaCache sync.Map
+
+type typeMetaKey struct {
+	t  reflect.Type
+	pc uintptr
+}
+
+type typeMeta struct {
+	name        string
+	fullName    string
+	in          []reflect.Type
+	hasReceiver bool
+	variadic    bool
+
+	paramsOnce    sync.Once
+	params        []*Reflection
+	variadicParam *Reflection
+}
+
+func loadTypeMeta(v reflect.Value, t reflect.Type) *typeMeta {
+	key := typeMetaKey{t: t}
+	if t.Kind() == reflect.Func && v.IsValid() {
+		key.pc = v.Pointer()
+	}
+
+	if cached, ok := typeMetaCache.Load(key); ok {
+		return cached.(*typeMeta)
+	}
+
+	meta, _ := typeMetaCache.LoadOrStore(key, newTypeMeta(key))
+
+	return meta.(*typeMeta)
+}
+
+func newTypeMeta(key typeMetaKey) *typeMeta {
+	t := key.t
+
+	if t.Kind() != reflect.Func {
+		return &typeMeta{
+			name:     elemType(t).Name(),
+			fullName: typeFullName(t),
+		}
+	}
+
+	funcName := runtime.FuncForPC(key.pc).Name()
+	paths := strings.Split(funcName, ".")
+
+	meta := &typeMeta{
+		name:     paths[len(paths)-1],
+		fullName: funcName,
+		in:       make([]reflect.Type, t.NumIn()),
+		variadic: t.IsVariadic(),
+	}
+
+	for i := range meta.in {
+		meta.in[i] = t.In(i)
+	}
+
+	meta.hasReceiver = funcHasReceiver(t, meta.name)
+	if meta.hasReceiver {
+		meta.fullName = t.In(0).PkgPath() + "/" + t.In(0).Name() + ":" + meta.name
+	}
+
+	return meta
+}
+
+func (m *typeMeta) paramPrototypes() ([]*Reflection, *Reflection) {
+	m.paramsOnce.Do(func() {
+		m.params = make([]*Reflection, len(m.in))
+		for i, paramType := range m.in {
+			m.params[i] = reflectType(paramType)
+		}
+
+		if m.variadic {
+			m.variadicParam = reflectType(m.in[len(m.in)-1].Elem())
+		}
+	})
+
+	return m.params, m.variadicParam
+}
+
+func funcHasReceiver(t reflect.Type, name string) bool {
+	if t.NumIn() == 0 {
+		return false
+	}
+
+	first := t.In(0)
+	if first.Kind() != reflect.Struct && first.Kind() != reflect.Ptr {
+		return false
+	}
+
+	if _, ok := first.MethodByName(name); !ok {
+		return false
+	}
+
+	return true
+}
+
+func typeFullName(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		return typeFullName(t.Elem())
+	}
+
+	return t.PkgPath() + "/" + t.Name()
+}
+
+func elemType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,116 @@
+package reflectify
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTypeMetaCache(t *testing.T) {
+	t.Run("reflections of the same type should share metadata", func(t *testing.T) {
+		first := Reflect(TestStruct{})
+		second := Reflect(TestStruct{Field1: "other"})
+
+		if first.meta != second.meta {
+			t.Errorf("metadata should be cached per type")
+		}
+	})
+
+	t.Run("functions of the same type should not share metadata", func(t *testing.T) {
+		first := Reflect(TestStruct.Test)
+		second := Reflect(TestStruct.private)
+
+		if first.meta == second.meta {
+			t.Errorf("metadata of functions should be cached per function")
+		}
+		if first.Name() != "Test" || second.Name() != "private" {
+			t.Errorf("names '%s' and '%s' given. expected: '%s' and '%s'", first.Name(), second.Name(), "Test", "private")
+		}
+	})
+
+	t.Run("metadata should hold the input types of a function", func(t *testing.T) {
+		refl := Reflect(TestStruct.TestWithMultiParam)
+
+		expected := []reflect.Type{reflect.TypeOf(TestStruct{}), reflect.TypeOf(&TestStruct2{}), reflect.TypeOf("")}
+		if !reflect.DeepEqual(refl.meta.in, expected) {
+			t.Errorf("input types %v given. expected: %v", refl.meta.in, expected)
+		}
+		if !refl.meta.hasReceiver {
+			t.Errorf("receiver should be detected")
+		}
+	})
+
+	t.Run("param prototypes should be cached while elements stay per call", func(t *testing.T) {
+		refl := Reflect(func(testStruct *TestStruct, name string) {})
+		elements := make([]any, 0)
+		refl.AddResolver(func(rec *Reflection, parameter any) (any, bool) {
+			if rec.IsPointer() {
+				elements = append(elements, rec.Element())
+			}
+
+			return nil, false
+		})
+
+		refl.Call(&TestStruct{}, "first")
+		refl.Call(&TestStruct{}, "second")
+
+		params, _ := refl.meta.paramPrototypes()
+		cached, _ := refl.meta.paramPrototypes()
+		if len(params) != 2 || params[0] != cached[0] {
+			t.Errorf("param prototypes should be built once")
+		}
+		if len(elements) != 2 || elements[0] == elements[1] || elements[0] == params[0].element {
+			t.Errorf("pointer params should get a new element per call")
+		}
+	})
+
+	t.Run("fullname of nil pointer should be resolved by type", func(t *testing.T) {
+		var ts *TestStruct
+		refl := Reflect(ts)
+
+		expected := "github.com/evolidev/reflectify/TestStruct"
+		if refl.FullName() != expected {
+			t.Errorf("name expected to be '%s'. '%s' given", expected, refl.FullName())
+		}
+	})
+}
+
+func BenchmarkDirectCall(b *testing.B) {
+	fn := reflect.ValueOf(func(a int, s string) string { return s })
+	params := []reflect.Value{reflect.ValueOf(1), reflect.ValueOf("x")}
+
+	for i := 0; i < b.N; i++ {
+		fn.Call(params)
+	}
+}
+
+func BenchmarkCall(b *testing.B) {
+	refl := Reflect(func(a int, s string) string { return s })
+
+	for i := 0; i < b.N; i++ {
+		refl.Call(1, "x")
+	}
+}
+
+func BenchmarkCallWithConversion(b *testing.B) {
+	refl := Reflect(func(a int, s string) string { return s })
+
+	for i := 0; i < b.N; i++ {
+		refl.Call("1", 2)
+	}
+}
+
+func BenchmarkCallWithReceiver(b *testing.B) {
+	refl := Reflect(TestStruct.TestWithScalarParam)
+
+	for i := 0; i < b.N; i++ {
+		refl.Call(TestStruct{}, "x")
+	}
+}
+
+func BenchmarkReflectAndCall(b *testing.B) {
+	fn := func(a int, s string) string { return s }
+
+	for i := 0; i < b.N; i++ {
+		Reflect(fn).Call(1, "x")
+	}
+}
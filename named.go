@@ -0,0 +1,162 @@
+package reflectify
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+type ParamNameSource func(fn reflect.Value) ([]string, bool)
+
+var paramNameSources struct {
+	sync.RWMutex
+	sources []ParamNameSource
+}
+
+func RegisterParamNameSource(source ParamNameSource) {
+	paramNameSources.Lock()
+	defer paramNameSources.Unlock()
+
+	paramNameSources.sources = append(paramNameSources.sources, source)
+}
+
+func (r *Reflection) WithParamNames(names ...string) *Reflection {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.paramNames = names
+
+	return r
+}
+
+func (r *Reflection) CallNamed(args map[string]any) ([]reflect.Value, error) {
+	return r.safeCall(func() ([]reflect.Value, *argumentCursor, error) {
+		callParams, err := r.buildNamedInputParameters(args)
+
+		return callParams, nil, err
+	})
+}
+
+func (r *Reflection) buildNamedInputParameters(args map[string]any) ([]reflect.Value, error) {
+	names := r.ParamNames()
+
+	offset := r.receiverOffset()
+	if len(names) != len(r.meta.in)-offset {
+		return nil, ErrParamNamesMismatch
+	}
+
+	inv := r.newInvocation(nil)
+	params := make([]reflect.Value, 0, len(r.meta.in))
+	namedErr := &NamedArgumentError{}
+
+	if r.meta.hasReceiver {
+		receiver := r.newParamReflection(0, inv.mapping)
+		receiver.isReceiver = true
+
+		params = append(params, inv.resolve(receiver))
+	}
+
+	for i, name := range names {
+		paramType := r.meta.in[i+offset]
+		arg, given := args[name]
+		variadic := i+offset == len(r.meta.in)-1 && r.meta.variadic
+
+		if variadic {
+			inv.cursor = newArgumentCursor(variadicArguments(arg, given))
+			params = append(params, r.resolveVariadic(inv, paramType))
+			continue
+		}
+
+		currentInputParam := r.newParamReflection(i+offset, inv.mapping)
+
+		if !given {
+			inv.cursor = newArgumentCursor(nil)
+
+			resolved, ok := inv.resolveCustom(currentInputParam)
+			if !ok {
+				namedErr.Missing = append(namedErr.Missing, name)
+			}
+
+			params = append(params, resolved)
+			continue
+		}
+
+		inv.cursor = newArgumentCursor([]any{arg})
+		params = append(params, inv.resolve(currentInputParam))
+	}
+
+	for name := range args {
+		if !containsString(names, name) {
+			namedErr.Unknown = append(namedErr.Unknown, name)
+		}
+	}
+
+	if len(namedErr.Missing) > 0 || len(namedErr.Unknown) > 0 {
+		sort.Strings(namedErr.Unknown)
+
+		return nil, namedErr
+	}
+
+	return params, nil
+}
+
+func (r *Reflection) ParamNames() []string {
+	r.mu.RLock()
+	names := r.paramNames
+	r.mu.RUnlock()
+
+	if names != nil || r.t == nil || r.t.Kind() != reflect.Func {
+		return names
+	}
+
+	paramNameSources.RLock()
+	defer paramNameSources.RUnlock()
+
+	for _, source := range paramNameSources.sources {
+		if names, ok := source(r.v); ok && len(names) == r.t.NumIn()-r.receiverOffset() {
+			return names
+		}
+	}
+
+	return nil
+}
+
+func (r *Reflection) ParamName() string {
+	return r.paramName
+}
+
+func (r *Reflection) receiverOffset() int {
+	if r.meta.hasReceiver {
+		return 1
+	}
+
+	return 0
+}
+
+func variadicArguments(arg any, given bool) []any {
+	if !given {
+		return nil
+	}
+
+	v := reflect.ValueOf(arg)
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+		return []any{arg}
+	}
+
+	arguments := make([]any, v.Len())
+	for i := range arguments {
+		arguments[i] = v.Index(i).Interface()
+	}
+
+	return arguments
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
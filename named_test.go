@@ -0,0 +1,159 @@
+package reflectify
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCallNamed(t *testing.T) {
+	t.Run("call named should map names to positions", func(t *testing.T) {
+		var id int
+		var name string
+		refl := Reflect(func(i int, n string) { id, name = i, n }).WithParamNames("id", "name")
+
+		_, err := refl.CallNamed(map[string]any{"name": "john", "id": "5"})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if id != 5 || name != "john" {
+			t.Errorf("arguments (%d, %s) given. expected: (%d, %s)", id, name, 5, "john")
+		}
+	})
+
+	t.Run("call named should report missing names", func(t *testing.T) {
+		refl := Reflect(func(id int, name string) {}).WithParamNames("id", "name")
+
+		_, err := refl.CallNamed(map[string]any{"id": 1})
+
+		var namedErr *NamedArgumentError
+		if !errors.As(err, &namedErr) {
+			t.Fatalf("expected named argument error. %v given", err)
+		}
+		if !reflect.DeepEqual(namedErr.Missing, []string{"name"}) {
+			t.Errorf("missing names %v given. expected: %v", namedErr.Missing, []string{"name"})
+		}
+	})
+
+	t.Run("call named should report unknown names", func(t *testing.T) {
+		refl := Reflect(func(id int) {}).WithParamNames("id")
+
+		_, err := refl.CallNamed(map[string]any{"id": 1, "page": 2, "limit": 3})
+
+		var namedErr *NamedArgumentError
+		if !errors.As(err, &namedErr) {
+			t.Fatalf("expected named argument error. %v given", err)
+		}
+		if !reflect.DeepEqual(namedErr.Unknown, []string{"limit", "page"}) {
+			t.Errorf("unknown names %v given. expected: %v", namedErr.Unknown, []string{"limit", "page"})
+		}
+	})
+
+	t.Run("call named should not report params resolved by custom resolvers as missing", func(t *testing.T) {
+		var tmp string
+		refl := Reflect(func(testStruct *TestStruct, name string) { tmp = testStruct.Field1 + name }).
+			WithParamNames("testStruct", "name")
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			if definition.InstanceOf(&TestStruct{}) {
+				return &TestStruct{Field1: "injected-"}, false
+			}
+
+			return nil, false
+		})
+
+		_, err := refl.CallNamed(map[string]any{"name": "john"})
+
+		if err != nil || tmp != "injected-john" {
+			t.Errorf("current value '%s' given. expected: %s. error: %v", tmp, "injected-john", err)
+		}
+	})
+
+	t.Run("call named should resolve the receiver without a name", func(t *testing.T) {
+		refl := Reflect(TestStruct.TestWithScalarParam).WithParamNames("t")
+
+		result, err := refl.CallNamed(map[string]any{"t": "x"})
+
+		if err != nil || result[0].String() != "hello" {
+			t.Errorf("method not called. error: %v", err)
+		}
+	})
+
+	t.Run("call named should gather variadic arguments", func(t *testing.T) {
+		var tmp []int
+		refl := Reflect(func(prefix string, xs ...int) { tmp = xs }).WithParamNames("prefix", "xs")
+
+		_, err := refl.CallNamed(map[string]any{"prefix": "a", "xs": []string{"1", "2"}})
+
+		if err != nil || !reflect.DeepEqual(tmp, []int{1, 2}) {
+			t.Errorf("current value '%v' given. expected: %v. error: %v", tmp, []int{1, 2}, err)
+		}
+	})
+
+	t.Run("call named should allow missing variadic arguments", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(prefix string, xs ...int) { called = len(xs) == 0 }).WithParamNames("prefix", "xs")
+
+		_, err := refl.CallNamed(map[string]any{"prefix": "a"})
+
+		if err != nil || !called {
+			t.Errorf("func should be called without variadic arguments. error: %v", err)
+		}
+	})
+
+	t.Run("call named should fail if names do not match params", func(t *testing.T) {
+		refl := Reflect(func(id int, name string) {}).WithParamNames("id")
+
+		_, err := refl.CallNamed(map[string]any{"id": 1})
+
+		if !errors.Is(err, ErrParamNamesMismatch) {
+			t.Errorf("expected param names mismatch error. %v given", err)
+		}
+	})
+
+	t.Run("call named should fail without param names", func(t *testing.T) {
+		refl := Reflect(func(id int) {})
+
+		_, err := refl.CallNamed(map[string]any{"id": 1})
+
+		if !errors.Is(err, ErrParamNamesMismatch) {
+			t.Errorf("expected param names mismatch error. %v given", err)
+		}
+	})
+}
+
+func TestParamNames(t *testing.T) {
+	t.Run("params should expose explicit names", func(t *testing.T) {
+		params := Reflect(func(id int, name string) {}).WithParamNames("id", "name").Params()
+
+		if params[0].ParamName() != "id" || params[1].ParamName() != "name" {
+			t.Errorf("names '%s' and '%s' given. expected: '%s' and '%s'", params[0].ParamName(), params[1].ParamName(), "id", "name")
+		}
+	})
+
+	t.Run("params should have empty names if unknown", func(t *testing.T) {
+		params := Reflect(func(id int) {}).Params()
+
+		if params[0].ParamName() != "" {
+			t.Errorf("name should be empty. '%s' given", params[0].ParamName())
+		}
+	})
+
+	t.Run("registered sources should provide names", func(t *testing.T) {
+		fn := func(id int) {}
+		pc := reflect.ValueOf(fn).Pointer()
+		RegisterParamNameSource(func(candidate reflect.Value) ([]string, bool) {
+			if candidate.Pointer() != pc {
+				return nil, false
+			}
+
+			return []string{"id"}, true
+		})
+
+		_, err := Reflect(fn).CallNamed(map[string]any{"id": 1})
+
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
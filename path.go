@@ -0,0 +1,308 @@
+package reflectify
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type pathSegment struct {
+	key  string
+	path string
+}
+
+func (r *Reflection) Get(path string) (any, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	mapping := r.mapping
+	current := reflect.ValueOf(r.element)
+
+	for _, segment := range segments {
+		current, err = lookupSegment(current, segment, mapping)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !current.IsValid() {
+		return nil, nil
+	}
+
+	return current.Interface(), nil
+}
+
+func (r *Reflection) Set(path string, value any) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.t == nil {
+		return ErrTypeMismatch
+	}
+
+	root := reflect.ValueOf(r.element)
+	if !root.IsValid() {
+		root = reflect.Zero(r.t)
+	}
+
+	updated, err := assignPath(root, segments, value, r.mapping)
+	if err != nil {
+		var pathErr *PathError
+		if !errors.As(err, &pathErr) {
+			err = &PathError{Path: path, Err: err}
+		}
+
+		return err
+	}
+
+	r.element = updated.Interface()
+
+	return nil
+}
+
+func parsePath(path string) ([]pathSegment, error) {
+	segments := make([]pathSegment, 0)
+	invalid := &PathError{Path: path, Err: ErrInvalidPath}
+
+	for i := 0; i < len(path); {
+		var key string
+
+		if path[i] == '[' {
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, invalid
+			}
+
+			key = path[i+1 : i+end]
+			i += end + 1
+		} else {
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+
+			key = path[i : i+end]
+			i += end
+		}
+
+		if key == "" {
+			return nil, invalid
+		}
+
+		segments = append(segments, pathSegment{key: key, path: path[:i]})
+
+		if i < len(path) && path[i] == '.' {
+			i++
+			if i == len(path) {
+				return nil, invalid
+			}
+		} else if i < len(path) && path[i] != '[' {
+			return nil, invalid
+		}
+	}
+
+	return segments, nil
+}
+
+func lookupSegment(current reflect.Value, segment pathSegment, mapping mappingOptions) (reflect.Value, error) {
+	for current.Kind() == reflect.Ptr || current.Kind() == reflect.Interface {
+		if current.IsNil() {
+			return reflect.Value{}, &PathError{Path: segment.path, Err: ErrPathNotFound}
+		}
+
+		current = current.Elem()
+	}
+
+	switch current.Kind() {
+	case reflect.Struct:
+		field, err := structField(current.Type(), segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		value, err := current.FieldByIndexErr(field.Index)
+		if err != nil {
+			return reflect.Value{}, &PathError{Path: segment.path, Err: ErrPathNotFound}
+		}
+
+		return value, nil
+	case reflect.Map:
+		key, err := convertValue(segment.key, current.Type().Key(), mapping)
+		if err != nil {
+			return reflect.Value{}, &PathError{Path: segment.path, Err: err}
+		}
+
+		value := current.MapIndex(key)
+		if !value.IsValid() {
+			return reflect.Value{}, &PathError{Path: segment.path, Err: ErrPathNotFound}
+		}
+
+		return value, nil
+	case reflect.Slice, reflect.Array:
+		index, err := sliceIndex(current, segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return current.Index(index), nil
+	default:
+		return reflect.Value{}, &PathError{Path: segment.path, Err: ErrPathNotFound}
+	}
+}
+
+func assignPath(current reflect.Value, segments []pathSegment, value any, mapping mappingOptions) (reflect.Value, error) {
+	if len(segments) == 0 {
+		return convertValue(value, current.Type(), mapping)
+	}
+
+	segment := segments[0]
+
+	switch current.Kind() {
+	case reflect.Ptr:
+		ptr := current
+		if ptr.IsNil() {
+			ptr = reflect.New(current.Type().Elem())
+		}
+
+		updated, err := assignPath(ptr.Elem(), segments, value, mapping)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		ptr.Elem().Set(updated)
+
+		return ptr, nil
+	case reflect.Interface:
+		if current.IsNil() {
+			return reflect.Value{}, &PathError{Path: segment.path, Err: ErrPathNotFound}
+		}
+
+		updated, err := assignPath(current.Elem(), segments, value, mapping)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result := reflect.New(current.Type()).Elem()
+		result.Set(updated)
+
+		return result, nil
+	case reflect.Struct:
+		field, err := structField(current.Type(), segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result := reflect.New(current.Type()).Elem()
+		result.Set(current)
+
+		target, err := fieldByIndex(result, field.Index)
+		if err != nil {
+			return reflect.Value{}, &PathError{Path: segment.path, Err: err}
+		}
+
+		updated, err := assignPath(target, segments[1:], value, mapping)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		target.Set(updated)
+
+		return result, nil
+	case reflect.Map:
+		result := current
+		if result.IsNil() {
+			result = reflect.MakeMap(current.Type())
+		}
+
+		key, err := convertValue(segment.key, current.Type().Key(), mapping)
+		if err != nil {
+			return reflect.Value{}, &PathError{Path: segment.path, Err: err}
+		}
+
+		element := result.MapIndex(key)
+		if !element.IsValid() {
+			element = reflect.Zero(current.Type().Elem())
+		}
+
+		updated, err := assignPath(element, segments[1:], value, mapping)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.SetMapIndex(key, updated)
+
+		return result, nil
+	case reflect.Slice, reflect.Array:
+		index, err := sliceIndex(current, segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result := current
+		if current.Kind() == reflect.Array {
+			result = reflect.New(current.Type()).Elem()
+			result.Set(current)
+		}
+
+		updated, err := assignPath(result.Index(index), segments[1:], value, mapping)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Index(index).Set(updated)
+
+		return result, nil
+	default:
+		return reflect.Value{}, &PathError{Path: segment.path, Err: ErrPathNotFound}
+	}
+}
+
+func structField(t reflect.Type, segment pathSegment) (reflect.StructField, error) {
+	field, found := t.FieldByName(segment.key)
+	if !found {
+		return field, &PathError{Path: segment.path, Err: ErrPathNotFound}
+	}
+
+	if !field.IsExported() {
+		return field, &PathError{Path: segment.path, Err: ErrUnexportedField}
+	}
+
+	return field, nil
+}
+
+func sliceIndex(current reflect.Value, segment pathSegment) (int, error) {
+	index, err := strconv.Atoi(segment.key)
+	if err != nil {
+		return 0, &PathError{Path: segment.path, Err: ErrInvalidPath}
+	}
+
+	if index < 0 || index >= current.Len() {
+		return 0, &PathError{Path: segment.path, Err: ErrPathNotFound}
+	}
+
+	return index, nil
+}
+
+func convertValue(value any, t reflect.Type, mapping mappingOptions) (reflect.Value, error) {
+	converted, err := (&Mapper{value: value, mappingOptions: mapping}).Convert(t)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	if converted == nil {
+		return reflect.Zero(t), nil
+	}
+
+	return reflect.ValueOf(converted), nil
+}
@@ -0,0 +1,255 @@
+package reflectify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	order := &testOrder{
+		ID:       5,
+		Customer: testCustomer{Name: "john", Address: testAddress{City: "Zurich"}},
+		Items:    []testItem{{Price: 1.5}, {Price: 2.5}},
+	}
+
+	t.Run("get should resolve dotted fields", func(t *testing.T) {
+		value, err := Reflect(order).Get("Customer.Address.City")
+
+		if err != nil || value != "Zurich" {
+			t.Errorf("value '%v' given. expected: %s. error: %v", value, "Zurich", err)
+		}
+	})
+
+	t.Run("get should resolve slice indices", func(t *testing.T) {
+		value, err := Reflect(order).Get("Items[1].Price")
+
+		if err != nil || value != 2.5 {
+			t.Errorf("value '%v' given. expected: %f. error: %v", value, 2.5, err)
+		}
+	})
+
+	t.Run("get should resolve map keys", func(t *testing.T) {
+		refl := Reflect(map[string]any{"filters": map[int]string{1: "a"}, "a.b": "dotted"})
+
+		value, err := refl.Get("filters.1")
+		if err != nil || value != "a" {
+			t.Errorf("value '%v' given. expected: %s. error: %v", value, "a", err)
+		}
+
+		value, err = refl.Get("[a.b]")
+		if err != nil || value != "dotted" {
+			t.Errorf("value '%v' given. expected: %s. error: %v", value, "dotted", err)
+		}
+	})
+
+	t.Run("get with empty path should return element", func(t *testing.T) {
+		value, err := Reflect(order).Get("")
+
+		if err != nil || value != order {
+			t.Errorf("element should be returned. error: %v", err)
+		}
+	})
+
+	t.Run("get should report missing paths", func(t *testing.T) {
+		paths := []string{"Unknown", "Items[5]", "Customer.Name.Length", "CreatedBy"}
+
+		for _, path := range paths {
+			_, err := Reflect(order).Get(path)
+
+			if !errors.Is(err, ErrPathNotFound) {
+				t.Errorf("expected path not found error for %s. %v given", path, err)
+			}
+		}
+	})
+
+	t.Run("get should report the failing path", func(t *testing.T) {
+		_, err := Reflect(order).Get("Items[5].Price")
+
+		var pathErr *PathError
+		if !errors.As(err, &pathErr) || pathErr.Path != "Items[5]" {
+			t.Errorf("expected path error for Items[5]. %v given", err)
+		}
+	})
+
+	t.Run("get should refuse unexported fields", func(t *testing.T) {
+		_, err := Reflect(order).Get("note")
+
+		if !errors.Is(err, ErrUnexportedField) {
+			t.Errorf("expected unexported field error. %v given", err)
+		}
+	})
+
+	t.Run("get should report invalid paths", func(t *testing.T) {
+		paths := []string{".ID", "ID.", "Items..Price", "Items[1", "Items[]", "Items[1]Price", "Items[a]"}
+
+		for _, path := range paths {
+			_, err := Reflect(order).Get(path)
+
+			if !errors.Is(err, ErrInvalidPath) {
+				t.Errorf("expected invalid path error for %s. %v given", path, err)
+			}
+		}
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Run("set should convert and assign nested fields", func(t *testing.T) {
+		order := &testOrder{Items: []testItem{{}, {}}}
+		refl := Reflect(order)
+
+		if err := refl.Set("Customer.Address.City", "Zurich"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := refl.Set("Items[1].Price", "2.5"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if order.Customer.Address.City != "Zurich" || order.Items[1].Price != 2.5 {
+			t.Errorf("values not set. %+v given", order)
+		}
+	})
+
+	t.Run("set should allocate nil pointers", func(t *testing.T) {
+		var target struct {
+			Customer *testCustomer
+		}
+
+		refl := Reflect(&target)
+
+		if err := refl.Set("Customer.Address.City", "Zurich"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if target.Customer == nil || target.Customer.Address.City != "Zurich" {
+			t.Errorf("pointer not allocated")
+		}
+	})
+
+	t.Run("set should create maps and convert keys", func(t *testing.T) {
+		var target struct {
+			Limits map[int]int
+		}
+
+		refl := Reflect(&target)
+
+		if err := refl.Set("Limits.5", "10"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(target.Limits, map[int]int{5: 10}) {
+			t.Errorf("map %v given. expected: %v", target.Limits, map[int]int{5: 10})
+		}
+	})
+
+	t.Run("set should update non pointer elements", func(t *testing.T) {
+		refl := Reflect(testOrder{})
+
+		if err := refl.Set("ID", 3); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if refl.Element().(testOrder).ID != 3 {
+			t.Errorf("element not updated. %+v given", refl.Element())
+		}
+	})
+
+	t.Run("set should update arrays inside maps", func(t *testing.T) {
+		refl := Reflect(map[string][2]int{"pair": {1, 2}})
+		if err := refl.Set("pair[1]", 7); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		if refl.Element().(map[string][2]int)["pair"] != [2]int{1, 7} {
+			t.Errorf("array not updated. %v given", refl.Element())
+		}
+	})
+
+	t.Run("set should report out of range indices", func(t *testing.T) {
+		err := Reflect(&testOrder{}).Set("Items[0].Price", 1)
+
+		if !errors.Is(err, ErrPathNotFound) {
+			t.Errorf("expected path not found error. %v given", err)
+		}
+	})
+
+	t.Run("set should return conversion errors with path", func(t *testing.T) {
+		order := &testOrder{}
+
+		err := Reflect(order).Set("ID", "abc")
+
+		var pathErr *PathError
+		var convErr *ConversionError
+		if !errors.As(err, &pathErr) || pathErr.Path != "ID" || !errors.As(err, &convErr) {
+			t.Errorf("expected path error with conversion error. %v given", err)
+		}
+	})
+
+	t.Run("set should refuse unexported fields", func(t *testing.T) {
+		err := Reflect(&testOrder{}).Set("note", "test")
+
+		if !errors.Is(err, ErrUnexportedField) {
+			t.Errorf("expected unexported field error. %v given", err)
+		}
+	})
+}
+
+func TestPathConcurrency(t *testing.T) {
+	elements := map[string]any{
+		"pointer": &testOrder{},
+		"value":   testOrder{},
+	}
+
+	for name, element := range elements {
+		element := element
+
+		t.Run("get and set should be safe for concurrent use on a "+name, func(t *testing.T) {
+			refl := Reflect(element)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					if err := refl.Set("ID", i); err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+					if _, err := refl.Get("ID"); err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+					if _, err := refl.ToMap(); err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+
+					refl.Fill(map[string]any{"Customer": map[string]any{"Name": "john"}})
+					refl.Element()
+				}(i)
+			}
+
+			wg.Wait()
+		})
+	}
+	t.Run("get should be safe while fill writes nested maps", func(t *testing.T) {
+		type tagged struct {
+			Tags map[string]int
+		}
+
+		refl := Reflect(&tagged{Tags: map[string]int{"a": 1}})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				refl.Fill(map[string]any{"tags": map[string]any{"a": i, fmt.Sprint("k", i): i}})
+			}(i)
+			go func() {
+				defer wg.Done()
+				_, _ = refl.Get("Tags.a")
+			}()
+		}
+
+		wg.Wait()
+	})
+}
@@ -1,10 +1,10 @@
 package reflectify
 
 import (
-	"github.com/mitchellh/mapstructure"
+	"errors"
 	"reflect"
-	"runtime"
-	"strings"
+	"runtime/debug"
+	"sync"
 )
 
 type ParamResolver func(rec *Reflection, parameter any) (any, bool)
@@ -24,9 +24,15 @@ func Reflect(v any) *Reflection {
 		t = reflect.TypeOf(v)
 	}
 
+	var meta *typeMeta
+	if t != nil {
+		meta = loadTypeMeta(val, t)
+	}
+
 	return &Reflection{
 		v:               val,
 		t:               t,
+		meta:            meta,
 		resolvers:       make([]ParamResolver, 0),
 		isReceiver:      false,
 		element:         v,
@@ -37,50 +43,140 @@ func Reflect(v any) *Reflection {
 type Reflection struct {
 	v               reflect.Value
 	t               reflect.Type
+	meta            *typeMeta
+	mu              sync.RWMutex
 	resolvers       []ParamResolver
 	isReceiver      bool
+	hasArgument     bool
+	variadic        bool
+	paramNames      []string
+	paramName       string
+	mapping         mappingOptions
 	element         any
+	member          *memberInfo
 	defaultResolver ParamResolver
 }
 
 func (r *Reflection) Name() string {
-	if r.t.Kind() == reflect.Func {
-		funcName := r.functionName()
-		paths := strings.Split(funcName, ".")
+	return r.meta.name
+}
+
+func (r *Reflection) Call(parameters ...interface{}) []reflect.Value {
+	callParams, _ := r.buildInputParameters(parameters)
+	r.normalizeInputParameters(callParams)
+	if !r.mappingOptions().strict {
+		r.normalizeConversionErrors(callParams)
+	}
+
+	for _, param := range callParams {
+		if !param.IsValid() {
+			continue
+		}
 
-		return paths[len(paths)-1]
+		if _, ok := param.Interface().(error); ok {
+			result := make([]reflect.Value, 2)
+			result[1] = param
+
+			return result
+		}
 	}
 
-	return r.reflectElem().Name()
+	return r.callFunc(callParams)
 }
 
-func (r *Reflection) functionName() string {
-	return runtime.FuncForPC(r.v.Pointer()).Name()
+func (r *Reflection) CallE(parameters ...any) ([]reflect.Value, error) {
+	return r.safeCall(func() ([]reflect.Value, *argumentCursor, error) {
+		callParams, cursor := r.buildInputParameters(parameters)
+
+		return callParams, cursor, nil
+	})
 }
 
-func (r *Reflection) reflectElem() reflect.Type {
-	if r.t.Kind() == reflect.Ptr {
-		return r.t.Elem()
+func (r *Reflection) safeCall(build func() ([]reflect.Value, *argumentCursor, error)) (result []reflect.Value, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result = nil
+			err = &PanicError{Value: recovered, Stack: debug.Stack()}
+		}
+	}()
+
+	if r.t == nil || r.t.Kind() != reflect.Func {
+		return nil, ErrNotCallable
 	}
 
-	return r.t
+	callParams, cursor, err := build()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.validateInputParameters(callParams); err != nil {
+		return nil, err
+	}
+
+	if cursor != nil && cursor.hasCurrent() {
+		return nil, &UnexpectedArgumentError{Position: cursor.position, Got: reflect.TypeOf(cursor.current())}
+	}
+
+	return r.callFunc(callParams), nil
 }
 
-func (r *Reflection) Call(parameters ...interface{}) []reflect.Value {
-	r.addFallbackResolver()
+func (r *Reflection) normalizeInputParameters(callParams []reflect.Value) {
+	for i, param := range callParams {
+		if !param.IsValid() && isNillable(r.t.In(i)) {
+			callParams[i] = reflect.Zero(r.t.In(i))
+		}
+	}
+}
 
-	callParams := r.buildInputParameters(parameters)
+func (r *Reflection) normalizeConversionErrors(callParams []reflect.Value) {
+	for i, param := range callParams {
+		if !param.IsValid() || param.Type().AssignableTo(r.t.In(i)) {
+			continue
+		}
 
-	for _, param := range callParams {
-		if _, ok := param.Interface().(error); ok {
-			result := make([]reflect.Value, 2)
-			result[1] = param
+		var convErr *ConversionError
+		if err, ok := param.Interface().(error); ok && errors.As(err, &convErr) {
+			callParams[i] = newValue(r.t.In(i))
+		}
+	}
+}
 
-			return result
+func (r *Reflection) validateInputParameters(callParams []reflect.Value) error {
+	r.normalizeInputParameters(callParams)
+
+	for i, param := range callParams {
+		expected := r.t.In(i)
+
+		if !param.IsValid() {
+			return &ArgumentError{Index: i, Expected: expected}
+		}
+
+		if !param.Type().AssignableTo(expected) {
+			if err, ok := param.Interface().(error); ok {
+				resolverErr := &ResolverError{Index: i, Err: err}
+
+				var convErr *ConversionError
+				if errors.As(err, &convErr) {
+					return &ArgumentError{Index: i, Expected: expected, Got: convErr.From, Err: resolverErr}
+				}
+
+				return resolverErr
+			}
+
+			return &ArgumentError{Index: i, Expected: expected, Got: param.Type()}
 		}
 	}
 
-	return r.v.Call(callParams)
+	return nil
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	default:
+		return false
+	}
 }
 
 func (r *Reflection) CallMethod(s string, parameters ...interface{}) []reflect.Value {
@@ -89,100 +185,215 @@ func (r *Reflection) CallMethod(s string, parameters ...interface{}) []reflect.V
 	}
 
 	m := r.v.MethodByName(s)
+	if !m.IsValid() && r.v.Kind() != reflect.Ptr && r.v.IsValid() {
+		m = addressable(r.v).MethodByName(s)
+	}
+
 	refl := Reflect(m)
 
 	return refl.Call(parameters...)
 }
 
-func (r *Reflection) HasReceiver() bool {
-	if r.t.NumIn() == 0 {
-		return false
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v.Addr()
 	}
 
-	first := r.t.In(0)
-	if first.Kind() != reflect.Struct && first.Kind() != reflect.Ptr {
-		return false
-	}
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
 
-	if _, ok := first.MethodByName(r.Name()); !ok {
-		return false
-	}
+	return ptr
+}
 
-	return true
+func (r *Reflection) HasReceiver() bool {
+	return r.meta.hasReceiver
 }
 
 func (r *Reflection) AddResolver(resolver ParamResolver) {
-	r.resolvers = append(r.resolvers, resolver)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resolvers := make([]ParamResolver, len(r.resolvers), len(r.resolvers)+1)
+	copy(resolvers, r.resolvers)
+
+	r.resolvers = append(resolvers, resolver)
 }
 
-func (r *Reflection) resolve(currentInputParam *Reflection, parameters []interface{}) reflect.Value {
-	var param interface{}
-	if len(parameters) > 0 {
-		param = parameters[0]
-	} else {
-		param = nil
+func (r *Reflection) makeNewValueForInput(paramType reflect.Type) interface{} {
+	return newValue(paramType).Interface()
+}
+
+func newValue(t reflect.Type) reflect.Value {
+	if t.Kind() != reflect.Ptr {
+		return reflect.New(t).Elem()
 	}
 
-	for _, resolver := range r.resolvers {
-		resolved, paramUsed := resolver(currentInputParam, param)
+	ptr := reflect.New(t.Elem())
+	if t.Elem().Kind() == reflect.Ptr {
+		ptr.Elem().Set(newValue(t.Elem()))
+	}
 
-		if resolved != nil {
-			if paramUsed {
-				if len(parameters) > 0 {
-					parameters = parameters[1:]
-				}
-			}
+	return ptr
+}
+
+func (r *Reflection) buildInputParameters(parameters []interface{}) ([]reflect.Value, *argumentCursor) {
+	inv := r.newInvocation(parameters)
+	params := make([]reflect.Value, 0, len(r.meta.in))
+
+	for cnt, paramType := range r.meta.in {
+		currentInputParam := r.newParamReflection(cnt, inv.mapping)
+		if cnt == 0 && r.meta.hasReceiver {
+			currentInputParam.isReceiver = true
+		}
 
-			return reflect.ValueOf(resolved)
+		if cnt == len(r.meta.in)-1 && r.meta.variadic {
+			params = append(params, r.resolveVariadic(inv, paramType))
+			continue
 		}
+
+		params = append(params, inv.resolve(currentInputParam))
 	}
 
-	return reflect.ValueOf(param)
+	return params, inv.cursor
 }
 
-func (r *Reflection) makeNewValueForInput(paramType reflect.Type) interface{} {
-	if paramType.Kind() == reflect.Struct || paramType.Kind() == reflect.Ptr {
-		destination := reflect.New(paramType).Elem().Interface()
-		reflectValue := reflect.ValueOf(destination)
-		t := reflectValue.Type()
-		if t.Kind() == reflect.Ptr {
-			t = t.Elem()
+func (r *Reflection) resolveVariadic(inv *invocation, sliceType reflect.Type) reflect.Value {
+	remaining := inv.cursor.remaining()
+	if len(remaining) == 1 && remaining[0] != nil && reflect.TypeOf(remaining[0]).AssignableTo(sliceType) {
+		inv.cursor.advance()
+
+		return reflect.ValueOf(remaining[0])
+	}
+
+	elements := make([]reflect.Value, 0, len(remaining))
+	for inv.cursor.hasCurrent() {
+		position := inv.cursor.position
+		element := inv.resolve(r.newVariadicReflection(inv.mapping))
+
+		if !element.IsValid() && isNillable(sliceType.Elem()) {
+			element = reflect.Zero(sliceType.Elem())
+		}
+		if !element.IsValid() || !element.Type().AssignableTo(sliceType.Elem()) {
+			return element
 		}
 
-		tmp := reflect.New(t)
-		if paramType.Kind() != reflect.Ptr {
-			tmp = tmp.Elem()
+		elements = append(elements, element)
+
+		if inv.cursor.position == position {
+			break
 		}
+	}
 
-		destination = tmp.Interface()
+	if len(elements) == 0 {
+		return reflect.Zero(sliceType)
+	}
 
-		return destination
+	return reflect.Append(reflect.MakeSlice(sliceType, 0, len(elements)), elements...)
+}
+
+func (r *Reflection) callFunc(callParams []reflect.Value) []reflect.Value {
+	if r.meta.variadic {
+		return r.v.CallSlice(callParams)
 	}
 
-	return reflect.New(paramType).Elem().Interface()
+	return r.v.Call(callParams)
 }
 
-func (r *Reflection) addFallbackResolver() {
-	r.AddResolver(r.defaultResolver)
+func (r *Reflection) IsVariadic() bool {
+	if r.t.Kind() == reflect.Func {
+		return r.meta.variadic
+	}
+
+	return r.variadic
 }
 
-func (r *Reflection) buildInputParameters(parameters []interface{}) []reflect.Value {
-	params := make([]reflect.Value, 0)
-	cnt := len(params)
+func (r *Reflection) newParamReflection(index int, mapping mappingOptions) *Reflection {
+	params, _ := r.meta.paramPrototypes()
 
-	for cnt < r.t.NumIn() {
-		currentInputParam := Reflect(r.makeNewValueForInput(r.t.In(cnt)))
-		if cnt == 0 && r.HasReceiver() {
-			currentInputParam.isReceiver = true
-		}
+	return params[index].cloneParam(mapping)
+}
+
+func (r *Reflection) newVariadicReflection(mapping mappingOptions) *Reflection {
+	_, variadicParam := r.meta.paramPrototypes()
+
+	return variadicParam.cloneParam(mapping)
+}
+
+func (r *Reflection) cloneParam(mapping mappingOptions) *Reflection {
+	value, element := r.v, r.element
+	if r.t.Kind() == reflect.Ptr {
+		element = newValue(r.t).Interface()
+		value = reflect.ValueOf(element)
+	}
 
-		cnt++
+	return &Reflection{
+		v:               value,
+		t:               r.t,
+		meta:            r.meta,
+		mapping:         mapping,
+		element:         element,
+		defaultResolver: defaultResolver,
+	}
+}
+
+func reflectType(t reflect.Type) *Reflection {
+	element := newValue(t).Interface()
 
-		resolved := r.resolve(currentInputParam, parameters)
-		params = append(params, resolved)
+	value := reflect.ValueOf(element)
+	if !value.IsValid() {
+		value = reflect.Zero(t)
 	}
 
-	return params
+	return &Reflection{
+		v:               value,
+		t:               t,
+		meta:            loadTypeMeta(value, t),
+		element:         element,
+		defaultResolver: defaultResolver,
+	}
+}
+
+func (r *Reflection) SetStrict(strict bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.mapping.strict = strict
+}
+
+func (r *Reflection) mappingOptions() mappingOptions {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.mapping
+}
+
+func (r *Reflection) RegisterConverter(from, to reflect.Type, fn Converter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mapping.converters == nil {
+		r.mapping.converters = newConverterRegistry()
+	}
+
+	r.mapping.converters.register(from, to, fn)
+}
+
+func (r *Reflection) SetSeparators(separators ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.mapping.separators = separators
+}
+
+func (r *Reflection) SetMultiValueMode(mode MultiValueMode) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.mapping.multiValue = mode
+}
+
+func (r *Reflection) newMapper(value any) *Mapper {
+	return &Mapper{value: value, mappingOptions: r.mapping}
 }
 
 func (r *Reflection) InstanceOf(instance interface{}) bool {
@@ -206,19 +417,18 @@ func (r *Reflection) IsReceiver() bool {
 }
 
 func (r *Reflection) New() interface{} {
-	r.element = r.makeNewValueForInput(r.t)
+	element := r.makeNewValueForInput(r.t)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.element = element
 
-	return r.Element()
+	return element
 }
 
 func (r *Reflection) Fill(input interface{}) interface{} {
-	elem := r.element
-
-	if !r.IsPointer() {
-		mapstructure.WeakDecode(input, &elem)
-	} else {
-		mapstructure.WeakDecode(input, elem)
-	}
+	elem, _ := r.fill(input)
 
 	return elem
 }
@@ -228,6 +438,9 @@ func (r *Reflection) IsPointer() bool {
 }
 
 func (r *Reflection) Element() interface{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	return r.element
 }
 
@@ -236,36 +449,50 @@ func (r *Reflection) IsStruct() bool {
 }
 
 func (r *Reflection) IsScalar() bool {
-	return r.t.Kind() == reflect.Int ||
-		r.t.Kind() == reflect.String ||
-		r.t.Kind() == reflect.Bool ||
-		r.t.Kind() == reflect.Int8 ||
-		r.t.Kind() == reflect.Int16 ||
-		r.t.Kind() == reflect.Int32 ||
-		r.t.Kind() == reflect.Int64 ||
-		r.t.Kind() == reflect.Uint ||
-		r.t.Kind() == reflect.Uint8 ||
-		r.t.Kind() == reflect.Uint16 ||
-		r.t.Kind() == reflect.Uint32 ||
-		r.t.Kind() == reflect.Uint64
-}
-
-func (r *Reflection) Methods() map[string]*Reflection {
+	switch r.t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.String, reflect.Bool:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Reflection) Methods(options ...MemberOption) map[string]*Reflection {
 	result := make(map[string]*Reflection)
+	option := combineMemberOptions(options)
 
 	if r.t.Kind() == reflect.Func {
 		result[r.t.Name()] = r
 	}
 
-	for i := 0; i < r.t.NumMethod(); i++ {
-		m := r.t.Method(i)
-		tmp := Reflect(m.Func)
+	for _, m := range r.methodSet(option) {
+		tmp := r.methodReflection(m)
+		if option.has(DeclaredOnly) && tmp.IsPromoted() {
+			continue
+		}
+
 		result[m.Name] = tmp
 	}
 
+	if option.has(IncludeShadowed) && !option.has(DeclaredOnly) {
+		for name, method := range r.shadowedMethods(option) {
+			result[name] = method
+		}
+	}
+
 	return result
 }
 
+func (r *Reflection) methodReflection(m reflect.Method) *Reflection {
+	refl := Reflect(m.Func)
+	refl.member = methodOrigin(elemType(r.t), m.Name)
+
+	return refl
+}
+
 func (r *Reflection) Params() []*Reflection {
 	result := make([]*Reflection, 0)
 
@@ -273,73 +500,56 @@ func (r *Reflection) Params() []*Reflection {
 		return result
 	}
 
-	cnt := 0
+	names := r.ParamNames()
 
-	for cnt < r.t.NumIn() {
-		currentInputParam := Reflect(r.makeNewValueForInput(r.t.In(cnt)))
-		if cnt == 0 && r.HasReceiver() {
-			currentInputParam.isReceiver = true
-
-			cnt++
+	for cnt := range r.meta.in {
+		if cnt == 0 && r.meta.hasReceiver {
 			continue
 		}
 
-		cnt++
+		param := r.newParamReflection(cnt, r.mappingOptions())
+		param.variadic = cnt == len(r.meta.in)-1 && r.meta.variadic
+		if len(names) > len(result) {
+			param.paramName = names[len(result)]
+		}
 
-		result = append(result, currentInputParam)
+		result = append(result, param)
 	}
 
 	return result
 }
 
-func (r *Reflection) MethodByName(name string) *Reflection {
+func (r *Reflection) MethodByName(name string, options ...MemberOption) *Reflection {
 	if r.t.Kind() == reflect.Func {
 		return r
 	}
 
-	if method, found := r.t.MethodByName(name); found {
-		return Reflect(method.Func)
+	for _, method := range r.methodSet(combineMemberOptions(options)) {
+		if method.Name == name {
+			return r.methodReflection(method)
+		}
 	}
 
 	return nil
 }
 
 func (r *Reflection) FullName() string {
-	if r.t.Kind() == reflect.Ptr {
-		elem := r.v.Elem().Interface()
-		refl := Reflect(elem)
-
-		return refl.FullName()
-	}
-
-	if r.t.Kind() == reflect.Func && r.HasReceiver() {
-		path := r.t.In(0).PkgPath()
-		path += "/" + r.t.In(0).Name() + ":" + r.Name()
+	return r.meta.fullName
+}
 
-		return path
-	}
+func defaultResolver(rec *Reflection, parameter any) (any, bool) {
+	if parameter == nil {
+		if rec.hasArgument && isNillable(rec.t) {
+			return reflect.Zero(rec.t).Interface(), true
+		}
 
-	if r.t.Kind() == reflect.Func {
-		return runtime.FuncForPC(r.v.Pointer()).Name()
+		return rec.New(), true
 	}
 
-	return r.t.PkgPath() + "/" + r.Name()
-}
-
-var defaultResolver = func(rec *Reflection, parameter any) (any, bool) {
-	tmp := rec.New()
-	if parameter != nil {
-		m := NewMapper(parameter)
-		if rec.t.Kind() == reflect.Int {
-			tmp = m.Int()
-		} else if rec.t.Kind() == reflect.String {
-			tmp = m.String()
-		} else if rec.t.Kind() == reflect.Bool {
-			tmp = m.Bool()
-		} else {
-			tmp = parameter
-		}
+	converted, err := rec.newMapper(parameter).Convert(rec.t)
+	if err != nil {
+		return err, true
 	}
 
-	return tmp, true
+	return converted, true
 }
@@ -2,6 +2,8 @@ package reflectify
 
 import (
 	"errors"
+	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -422,6 +424,27 @@ func TestCallMethod(t *testing.T) {
 		}
 	})
 
+	t.Run("pointer receiver method of value should be called on a copy", func(t *testing.T) {
+		value := TestStruct{Field1: "test"}
+		refl := Reflect(value)
+
+		result := refl.CallMethod("TestWithPointerReceiver")
+
+		if result[0].String() != "test" {
+			t.Errorf("func not called")
+		}
+	})
+
+	t.Run("pointer receiver method of pointer should be called", func(t *testing.T) {
+		refl := Reflect(&TestStruct{Field1: "test"})
+
+		result := refl.CallMethod("TestWithPointerReceiver")
+
+		if result[0].String() != "test" {
+			t.Errorf("func not called")
+		}
+	})
+
 	t.Run("if method is function then it should be simple called", func(t *testing.T) {
 		refl := Reflect(func() string { return "test" })
 
@@ -582,6 +605,10 @@ func (s TestStruct) TestWithMultiParam(ts *TestStruct2, t string) string {
 	return "hello"
 }
 
+func (s *TestStruct) TestWithPointerReceiver() string {
+	return s.Field1
+}
+
 func (s TestStruct) private() string {
 	return "hello"
 }
@@ -592,3 +619,701 @@ type TestStruct2 struct {
 func testFunc(param1 string, param2 TestStruct2) string {
 	return "test"
 }
+
+func TestCallE(t *testing.T) {
+	t.Run("call e should return the result of the function", func(t *testing.T) {
+		refl := Reflect(func(param int) int { return param })
+
+		result, err := refl.CallE("3")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result[0].Int() != 3 {
+			t.Errorf("current value '%d' given. expected: %d", result[0].Int(), 3)
+		}
+	})
+
+	t.Run("call e should return argument error if resolved value is not assignable", func(t *testing.T) {
+		refl := Reflect(func(testStruct *TestStruct) {})
+
+		_, err := refl.CallE("test")
+
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) {
+			t.Fatalf("expected argument error. %v given", err)
+		}
+		if argErr.Index != 0 {
+			t.Errorf("argument error index should be %d. %d given", 0, argErr.Index)
+		}
+		if argErr.Got.Kind() != reflect.String {
+			t.Errorf("argument error should report string as given type. %v given", argErr.Got)
+		}
+	})
+
+	t.Run("call e should return argument error if nil is given for none nillable param", func(t *testing.T) {
+		refl := Reflect(func(testStruct TestStruct) {})
+		refl.defaultResolver = func(rec *Reflection, parameter any) (any, bool) {
+			return nil, false
+		}
+
+		_, err := refl.CallE()
+
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) {
+			t.Fatalf("expected argument error. %v given", err)
+		}
+		if argErr.Got != nil {
+			t.Errorf("argument error should report nil as given type. %v given", argErr.Got)
+		}
+	})
+
+	t.Run("call e should return argument error for unconvertible struct input", func(t *testing.T) {
+		refl := Reflect(func(testStruct TestStruct) {})
+
+		_, err := refl.CallE("abc")
+
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) {
+			t.Fatalf("expected argument error. %v given", err)
+		}
+		if argErr.Got.Kind() != reflect.String {
+			t.Errorf("argument error should report string as given type. %v given", argErr.Got)
+		}
+
+		var resolverErr *ResolverError
+		var convErr *ConversionError
+		if !errors.As(err, &resolverErr) || !errors.As(err, &convErr) {
+			t.Errorf("argument error should wrap the conversion error in a resolver error. %v given", err)
+		}
+	})
+
+	t.Run("call e should return unexpected argument error for leftover arguments", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(a, b int) { called = true })
+
+		_, err := refl.CallE(1, 2, 3)
+
+		var unexpectedErr *UnexpectedArgumentError
+		if !errors.As(err, &unexpectedErr) {
+			t.Fatalf("expected unexpected argument error. %v given", err)
+		}
+		if unexpectedErr.Position != 2 {
+			t.Errorf("unexpected argument position should be %d. %d given", 2, unexpectedErr.Position)
+		}
+		if unexpectedErr.Got.Kind() != reflect.Int {
+			t.Errorf("unexpected argument error should report the int argument. %v given", unexpectedErr)
+		}
+		if called {
+			t.Errorf("func should not be called with leftover arguments")
+		}
+	})
+
+	t.Run("call e should count leftover arguments by caller position", func(t *testing.T) {
+		refl := Reflect(func(testStruct *TestStruct, id int) {})
+		refl.AddResolver(func(rec *Reflection, parameter any) (any, bool) {
+			if rec.InstanceOf(&TestStruct{}) {
+				return rec.New(), false
+			}
+
+			return nil, false
+		})
+
+		_, err := refl.CallE(1, 2)
+
+		var unexpectedErr *UnexpectedArgumentError
+		if !errors.As(err, &unexpectedErr) || unexpectedErr.Position != 1 {
+			t.Errorf("expected unexpected argument error at position %d. %v given", 1, err)
+		}
+	})
+
+	t.Run("call e should pass typed nil for nillable params", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(param *TestStruct) { called = param == nil })
+		refl.defaultResolver = func(rec *Reflection, parameter any) (any, bool) {
+			return nil, false
+		}
+
+		_, err := refl.CallE()
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !called {
+			t.Errorf("func should be called with nil")
+		}
+	})
+
+	t.Run("call e should return resolver error", func(t *testing.T) {
+		failed := errors.New("failed")
+		refl := Reflect(func(testStruct *TestStruct, tmp int) int { return tmp })
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			return failed, true
+		})
+
+		_, err := refl.CallE(5)
+
+		var resolverErr *ResolverError
+		if !errors.As(err, &resolverErr) {
+			t.Fatalf("expected resolver error. %v given", err)
+		}
+		if !errors.Is(err, failed) {
+			t.Errorf("resolver error should wrap the original error")
+		}
+	})
+
+	t.Run("call e should recover panics of the called function", func(t *testing.T) {
+		refl := Reflect(func() { panic("boom") })
+
+		_, err := refl.CallE()
+
+		var panicErr *PanicError
+		if !errors.As(err, &panicErr) {
+			t.Fatalf("expected panic error. %v given", err)
+		}
+		if panicErr.Value != "boom" {
+			t.Errorf("panic error should hold the panic value. %v given", panicErr.Value)
+		}
+		if len(panicErr.Stack) == 0 {
+			t.Errorf("panic error should hold the stack")
+		}
+	})
+
+	t.Run("call e should return error if reflected element is not a function", func(t *testing.T) {
+		refl := Reflect(TestStruct{})
+
+		_, err := refl.CallE()
+
+		if !errors.Is(err, ErrNotCallable) {
+			t.Errorf("expected not callable error. %v given", err)
+		}
+	})
+}
+
+func TestCallArgumentOrder(t *testing.T) {
+	t.Run("call should pass arguments in order", func(t *testing.T) {
+		a, b := 0, ""
+		refl := Reflect(func(first int, second string) { a, b = first, second })
+
+		refl.Call(1, "x")
+
+		if a != 1 || b != "x" {
+			t.Errorf("arguments (%d, %s) given. expected: (%d, %s)", a, b, 1, "x")
+		}
+	})
+
+	t.Run("call should skip not consumed arguments of resolvers", func(t *testing.T) {
+		var a, b int
+		refl := Reflect(func(first int, testStruct *TestStruct, second int) { a, b = first, second })
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			if definition.InstanceOf(&TestStruct{}) {
+				return definition.New(), false
+			}
+
+			return nil, false
+		})
+
+		refl.Call(1, 2)
+
+		if a != 1 || b != 2 {
+			t.Errorf("arguments (%d, %d) given. expected: (%d, %d)", a, b, 1, 2)
+		}
+	})
+
+	t.Run("call should advance arguments consumed by resolvers", func(t *testing.T) {
+		var field2 int
+		var second string
+		refl := Reflect(func(testStruct *TestStruct, s string) { field2, second = testStruct.field2, s })
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			if definition.InstanceOf(&TestStruct{}) {
+				ts := definition.New().(*TestStruct)
+				ts.field2 = param.(int)
+
+				return ts, true
+			}
+
+			return nil, false
+		})
+
+		refl.Call(7, "x")
+
+		if field2 != 7 || second != "x" {
+			t.Errorf("arguments (%d, %s) given. expected: (%d, %s)", field2, second, 7, "x")
+		}
+	})
+
+	t.Run("call should use zero values once arguments are exhausted", func(t *testing.T) {
+		var a, b int
+		refl := Reflect(func(first int, second int) { a, b = first, second })
+
+		refl.Call(1)
+
+		if a != 1 || b != 0 {
+			t.Errorf("arguments (%d, %d) given. expected: (%d, %d)", a, b, 1, 0)
+		}
+	})
+}
+
+func TestResolverPipeline(t *testing.T) {
+	t.Run("call should not grow the resolver chain", func(t *testing.T) {
+		refl := Reflect(func(param int) {})
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			return nil, false
+		})
+
+		for i := 0; i < 10; i++ {
+			refl.Call(i)
+		}
+
+		if len(refl.resolvers) != 1 {
+			t.Errorf("current len of %d does not match expected %d", len(refl.resolvers), 1)
+		}
+	})
+
+	t.Run("default resolver should run after custom resolvers", func(t *testing.T) {
+		order := make([]string, 0)
+		refl := Reflect(func(param int) {})
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			order = append(order, "custom")
+
+			return nil, false
+		})
+		refl.defaultResolver = func(rec *Reflection, parameter any) (any, bool) {
+			order = append(order, "default")
+
+			return 1, true
+		}
+
+		refl.Call(1)
+		refl.Call(1)
+
+		expected := []string{"custom", "default", "custom", "default"}
+		if !reflect.DeepEqual(order, expected) {
+			t.Errorf("resolvers called in order %v. expected: %v", order, expected)
+		}
+	})
+}
+
+func TestCallNumericParams(t *testing.T) {
+	t.Run("call should map value to int64", func(t *testing.T) {
+		var tmp int64
+		refl := Reflect(func(param int64) { tmp = param })
+
+		refl.Call("42")
+
+		if tmp != 42 {
+			t.Errorf("current value '%d' given. expected: %d", tmp, 42)
+		}
+	})
+
+	t.Run("call should map value to float", func(t *testing.T) {
+		var tmp float64
+		refl := Reflect(func(param float64) { tmp = param })
+
+		refl.Call("1.5")
+
+		if tmp != 1.5 {
+			t.Errorf("current value '%v' given. expected: %v", tmp, 1.5)
+		}
+	})
+
+	t.Run("call should map int64 to string", func(t *testing.T) {
+		tmp := ""
+		refl := Reflect(func(param string) { tmp = param })
+
+		refl.Call(int64(5))
+
+		if tmp != "5" {
+			t.Errorf("current value '%s' given. expected: %s", tmp, "5")
+		}
+	})
+
+	t.Run("call should map value to named scalar types", func(t *testing.T) {
+		type level uint8
+		var tmp level
+		refl := Reflect(func(param level) { tmp = param })
+
+		refl.Call(3)
+
+		if tmp != 3 {
+			t.Errorf("current value '%d' given. expected: %d", tmp, 3)
+		}
+	})
+}
+
+func TestStrictCall(t *testing.T) {
+	t.Run("strict call should fail on malformed input", func(t *testing.T) {
+		refl := Reflect(func(param int) {})
+		refl.SetStrict(true)
+
+		_, err := refl.CallE("test")
+
+		var convErr *ConversionError
+		if !errors.As(err, &convErr) {
+			t.Fatalf("expected conversion error. %v given", err)
+		}
+
+		var resolverErr *ResolverError
+		if !errors.As(err, &resolverErr) {
+			t.Errorf("conversion error should be reported as resolver error")
+		}
+	})
+
+	t.Run("strict call should fail on lossy input", func(t *testing.T) {
+		refl := Reflect(func(param int) {})
+		refl.SetStrict(true)
+
+		_, err := refl.CallE(1.5)
+
+		if !errors.Is(err, ErrLossyConversion) {
+			t.Errorf("expected lossy conversion error. %v given", err)
+		}
+	})
+
+	t.Run("strict call should pass valid input", func(t *testing.T) {
+		tmp := 0
+		refl := Reflect(func(param int) { tmp = param })
+		refl.SetStrict(true)
+
+		_, err := refl.CallE("12")
+
+		if err != nil || tmp != 12 {
+			t.Errorf("current value '%d' given. expected: %d. error: %v", tmp, 12, err)
+		}
+	})
+
+	t.Run("weak call should fall back to zero value", func(t *testing.T) {
+		tmp := -1
+		refl := Reflect(func(param int) { tmp = param })
+
+		refl.Call("test")
+
+		if tmp != 0 {
+			t.Errorf("current value '%d' given. expected: %d", tmp, 0)
+		}
+	})
+
+	t.Run("weak call e should report unconvertible input", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(param int) { called = true })
+
+		_, err := refl.CallE("test")
+
+		var argErr *ArgumentError
+		var convErr *ConversionError
+		if !errors.As(err, &argErr) || !errors.As(err, &convErr) {
+			t.Errorf("expected argument error with conversion error. %v given", err)
+		}
+		if called {
+			t.Errorf("func should not be called with unconvertible input")
+		}
+	})
+}
+
+func TestCallSliceParams(t *testing.T) {
+	t.Run("call should map separated string to slice", func(t *testing.T) {
+		var tmp []int
+		refl := Reflect(func(ids []int) { tmp = ids })
+
+		refl.Call("1,2,3")
+
+		if !reflect.DeepEqual(tmp, []int{1, 2, 3}) {
+			t.Errorf("current value '%v' given. expected: %v", tmp, []int{1, 2, 3})
+		}
+	})
+
+	t.Run("call should map string with configured separators to slice", func(t *testing.T) {
+		var tmp []string
+		refl := Reflect(func(tags []string) { tmp = tags })
+		refl.SetSeparators(" ")
+
+		refl.Call("a b")
+
+		if !reflect.DeepEqual(tmp, []string{"a", "b"}) {
+			t.Errorf("current value '%v' given. expected: %v", tmp, []string{"a", "b"})
+		}
+	})
+
+	t.Run("call should use zero value for malformed slice input", func(t *testing.T) {
+		tmp := []int{1}
+		refl := Reflect(func(ids []int) { tmp = ids })
+
+		refl.Call("1,x")
+
+		if len(tmp) != 0 {
+			t.Errorf("current value '%v' given. expected empty slice", tmp)
+		}
+	})
+
+	t.Run("call e should report malformed slice input", func(t *testing.T) {
+		refl := Reflect(func(ids []int) {})
+
+		_, err := refl.CallE("1,x")
+
+		var convErr *ConversionError
+		var resolverErr *ResolverError
+		if !errors.As(err, &convErr) || !errors.As(err, &resolverErr) {
+			t.Errorf("expected conversion error wrapped in resolver error. %v given", err)
+		}
+	})
+
+	t.Run("call should map slice to array", func(t *testing.T) {
+		var tmp [2]int
+		refl := Reflect(func(point [2]int) { tmp = point })
+
+		refl.Call([]any{1, "2"})
+
+		if tmp != [2]int{1, 2} {
+			t.Errorf("current value '%v' given. expected: %v", tmp, [2]int{1, 2})
+		}
+	})
+}
+
+func TestCallMapParams(t *testing.T) {
+	t.Run("call should map any map to typed map", func(t *testing.T) {
+		var tmp map[string]int
+		refl := Reflect(func(filters map[string]int) { tmp = filters })
+
+		refl.Call(map[string]any{"age": "30"})
+
+		if !reflect.DeepEqual(tmp, map[string]int{"age": 30}) {
+			t.Errorf("current value '%v' given. expected: %v", tmp, map[string]int{"age": 30})
+		}
+	})
+
+	t.Run("call should use zero value for malformed map input", func(t *testing.T) {
+		tmp := map[string]int{"b": 1}
+		refl := Reflect(func(filters map[string]int) { tmp = filters })
+
+		refl.Call(map[string]any{"a": "x"})
+
+		if len(tmp) != 0 {
+			t.Errorf("current value '%v' given. expected empty map", tmp)
+		}
+	})
+
+	t.Run("call e should report malformed map input", func(t *testing.T) {
+		refl := Reflect(func(filters map[string]int) {})
+
+		_, err := refl.CallE(map[string]any{"a": "x"})
+
+		var argErr *ArgumentError
+		var convErr *ConversionError
+		if !errors.As(err, &argErr) || !errors.As(err, &convErr) {
+			t.Errorf("expected argument error with conversion error. %v given", err)
+		}
+	})
+
+	t.Run("call should map url values with configured mode", func(t *testing.T) {
+		var tmp map[string]string
+		refl := Reflect(func(query map[string]string) { tmp = query })
+		refl.SetMultiValueMode(AllValues)
+
+		refl.Call(url.Values{"tag": {"a", "b"}})
+
+		if !reflect.DeepEqual(tmp, map[string]string{"tag": "a,b"}) {
+			t.Errorf("current value '%v' given. expected: %v", tmp, map[string]string{"tag": "a,b"})
+		}
+	})
+}
+
+func TestCallPointerParams(t *testing.T) {
+	t.Run("call should address value for pointer param", func(t *testing.T) {
+		var tmp *int
+		refl := Reflect(func(param *int) { tmp = param })
+
+		refl.Call(5)
+
+		if tmp == nil || *tmp != 5 {
+			t.Errorf("current value '%v' given. expected pointer to %d", tmp, 5)
+		}
+	})
+
+	t.Run("call should address value for nested pointer param", func(t *testing.T) {
+		var tmp **string
+		refl := Reflect(func(param **string) { tmp = param })
+
+		refl.Call(5)
+
+		if tmp == nil || *tmp == nil || **tmp != "5" {
+			t.Errorf("current value '%v' given. expected nested pointer to %s", tmp, "5")
+		}
+	})
+
+	t.Run("call should dereference pointer for value param", func(t *testing.T) {
+		tmp := 0
+		value := "7"
+		refl := Reflect(func(param int) { tmp = param })
+
+		refl.Call(&value)
+
+		if tmp != 7 {
+			t.Errorf("current value '%d' given. expected: %d", tmp, 7)
+		}
+	})
+
+	t.Run("call should pass typed nil for explicit nil", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(p *int, m map[string]int, s []int, e error) {
+			called = p == nil && m == nil && s == nil && e == nil
+		})
+
+		_, err := refl.CallE(nil, nil, nil, nil)
+
+		if err != nil || !called {
+			t.Errorf("func should be called with nil values. error: %v", err)
+		}
+	})
+
+	t.Run("call should allocate zero pointers for missing params", func(t *testing.T) {
+		var tmp **int
+		refl := Reflect(func(param **int) { tmp = param })
+
+		refl.Call()
+
+		if tmp == nil || *tmp == nil || **tmp != 0 {
+			t.Errorf("current value '%v' given. expected allocated zero pointer", tmp)
+		}
+	})
+
+	t.Run("call should pass zero value for explicit nil of none nillable param", func(t *testing.T) {
+		tmp := -1
+		refl := Reflect(func(param int) { tmp = param })
+
+		refl.Call(nil)
+
+		if tmp != 0 {
+			t.Errorf("current value '%d' given. expected: %d", tmp, 0)
+		}
+	})
+
+	t.Run("call should pass values implementing interface params", func(t *testing.T) {
+		var tmp error
+		failed := errors.New("failed")
+		refl := Reflect(func(param any, e error) { tmp = e })
+
+		_, err := refl.CallE(1, failed)
+
+		if err != nil || tmp != failed {
+			t.Errorf("current value '%v' given. expected: %v", tmp, failed)
+		}
+	})
+
+	t.Run("call should pass nil for missing interface params", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(param any) { called = param == nil })
+
+		refl.Call()
+
+		if !called {
+			t.Errorf("func should be called with nil interface")
+		}
+	})
+
+	t.Run("params should describe interface params", func(t *testing.T) {
+		refl := Reflect(func(param error) {})
+
+		params := refl.Params()
+
+		if params[0].t != reflect.TypeOf((*error)(nil)).Elem() {
+			t.Errorf("param type %v does not match expected error interface", params[0].t)
+		}
+	})
+}
+
+func TestVariadic(t *testing.T) {
+	t.Run("is variadic should report variadic functions", func(t *testing.T) {
+		if !Reflect(func(xs ...int) {}).IsVariadic() {
+			t.Errorf("func should be variadic")
+		}
+		if Reflect(func(xs []int) {}).IsVariadic() {
+			t.Errorf("func should not be variadic")
+		}
+	})
+
+	t.Run("params should mark the variadic param", func(t *testing.T) {
+		params := Reflect(func(prefix string, xs ...int) {}).Params()
+
+		if params[0].IsVariadic() || !params[1].IsVariadic() {
+			t.Errorf("only the last param should be variadic")
+		}
+	})
+
+	t.Run("call should gather remaining arguments", func(t *testing.T) {
+		var tmp []int
+		tmpPrefix := ""
+		refl := Reflect(func(prefix string, xs ...int) { tmpPrefix, tmp = prefix, xs })
+
+		refl.Call("a", 1, "2", 3.0)
+
+		if tmpPrefix != "a" || !reflect.DeepEqual(tmp, []int{1, 2, 3}) {
+			t.Errorf("arguments (%s, %v) given. expected: (%s, %v)", tmpPrefix, tmp, "a", []int{1, 2, 3})
+		}
+	})
+
+	t.Run("call should pass no variadic arguments", func(t *testing.T) {
+		tmp := []int{1}
+		refl := Reflect(func(prefix string, xs ...int) { tmp = xs })
+
+		refl.Call("a")
+
+		if len(tmp) != 0 {
+			t.Errorf("variadic arguments should be empty. %v given", tmp)
+		}
+	})
+
+	t.Run("call should spread a given slice", func(t *testing.T) {
+		var tmp []int
+		refl := Reflect(func(xs ...int) { tmp = xs })
+
+		refl.Call([]int{1, 2})
+
+		if !reflect.DeepEqual(tmp, []int{1, 2}) {
+			t.Errorf("current value '%v' given. expected: %v", tmp, []int{1, 2})
+		}
+	})
+
+	t.Run("call should run resolvers for variadic arguments", func(t *testing.T) {
+		var tmp []int
+		refl := Reflect(func(xs ...int) { tmp = xs })
+		refl.AddResolver(func(definition *Reflection, param any) (any, bool) {
+			if definition.InstanceOf(0) {
+				return NewMapper(param).Int() * 10, true
+			}
+
+			return nil, false
+		})
+
+		refl.Call(1, 2)
+
+		if !reflect.DeepEqual(tmp, []int{10, 20}) {
+			t.Errorf("current value '%v' given. expected: %v", tmp, []int{10, 20})
+		}
+	})
+
+	t.Run("call e should report unsupported variadic arguments", func(t *testing.T) {
+		called := false
+		refl := Reflect(func(xs ...string) { called = true })
+
+		_, err := refl.CallE([]string{"a"}, "b")
+
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) || argErr.Index != 0 || !errors.Is(err, ErrUnsupportedConversion) {
+			t.Errorf("expected argument error for variadic param. %v given", err)
+		}
+		if called {
+			t.Errorf("func should not be called with unsupported arguments")
+		}
+	})
+
+	t.Run("call e should report invalid variadic arguments", func(t *testing.T) {
+		refl := Reflect(func(xs ...*TestStruct) {})
+
+		_, err := refl.CallE("test")
+
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) || argErr.Index != 0 {
+			t.Errorf("expected argument error for variadic param. %v given", err)
+		}
+	})
+}
@@ -0,0 +1,270 @@
+package reflectify
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type MapOptions struct {
+	TagName   string
+	OmitEmpty bool
+	Squash    bool
+}
+
+type visit struct {
+	t   reflect.Type
+	ptr uintptr
+}
+
+type mapEncoder struct {
+	options  MapOptions
+	visiting map[visit]bool
+}
+
+func (r *Reflection) ToMap() (map[string]any, error) {
+	defaults := DefaultFillOptions()
+
+	return r.ToMapWith(MapOptions{TagName: defaults.TagName, Squash: defaults.Squash})
+}
+
+func (r *Reflection) ToMapWith(options MapOptions) (map[string]any, error) {
+	if options.TagName == "" {
+		options.TagName = "mapstructure"
+	}
+
+	encoder := &mapEncoder{options: options, visiting: make(map[visit]bool)}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	root := reflect.ValueOf(r.element)
+	for root.Kind() == reflect.Ptr && !root.IsNil() {
+		root = root.Elem()
+	}
+
+	if root.Kind() == reflect.Ptr {
+		t := root.Type()
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		if t.Kind() == reflect.Struct || t.Kind() == reflect.Map {
+			return nil, nil
+		}
+	}
+
+	if root.Kind() == reflect.Struct && !hasExportedFields(root.Type()) {
+		return map[string]any{}, nil
+	}
+
+	result, err := encoder.encode(reflect.ValueOf(r.element), "")
+	if err != nil {
+		return nil, err
+	}
+
+	if result, ok := result.(map[string]any); ok {
+		return result, nil
+	}
+
+	return nil, ErrTypeMismatch
+}
+
+func (e *mapEncoder) encode(v reflect.Value, path string) (any, error) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return e.encode(v.Elem(), path)
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return e.enter(v, path, func() (any, error) {
+			return e.encode(v.Elem(), path)
+		})
+	case reflect.Struct:
+		if !hasExportedFields(v.Type()) {
+			return interfaceOf(v), nil
+		}
+
+		return e.encodeStruct(v, path)
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return e.enter(v, path, func() (any, error) {
+			return e.encodeMap(v, path)
+		})
+	case reflect.Slice:
+		if v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			return interfaceOf(v), nil
+		}
+
+		return e.enter(v, path, func() (any, error) {
+			return e.encodeSlice(v, path)
+		})
+	case reflect.Array:
+		return e.encodeSlice(v, path)
+	default:
+		return interfaceOf(v), nil
+	}
+}
+
+func interfaceOf(v reflect.Value) any {
+	if !v.CanInterface() {
+		return nil
+	}
+
+	return v.Interface()
+}
+
+func (e *mapEncoder) enter(v reflect.Value, path string, encode func() (any, error)) (any, error) {
+	key := visit{t: v.Type(), ptr: v.Pointer()}
+	if e.visiting[key] {
+		return nil, &PathError{Path: path, Err: ErrCycle}
+	}
+
+	e.visiting[key] = true
+	defer delete(e.visiting, key)
+
+	return encode()
+}
+
+func (e *mapEncoder) encodeStruct(v reflect.Value, path string) (map[string]any, error) {
+	result := make(map[string]any)
+	squashed := make([]map[string]any, 0)
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, options := parseTag(field.Tag.Get(e.options.TagName))
+		if name == "-" {
+			continue
+		}
+
+		value := v.Field(i)
+
+		if field.Anonymous && e.squash(field, name, options) {
+			embedded, err := e.encode(value, path)
+			if err != nil {
+				return nil, err
+			}
+
+			if embedded, ok := embedded.(map[string]any); ok {
+				squashed = append(squashed, embedded)
+			}
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if (e.options.OmitEmpty || options["omitempty"]) && value.IsZero() {
+			continue
+		}
+
+		encoded, err := e.encode(value, joinPath(path, name))
+		if err != nil {
+			return nil, err
+		}
+
+		result[name] = encoded
+	}
+
+	for _, embedded := range squashed {
+		for name, value := range embedded {
+			if _, exists := result[name]; !exists {
+				result[name] = value
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (e *mapEncoder) squash(field reflect.StructField, name string, options map[string]bool) bool {
+	if options["squash"] {
+		return true
+	}
+
+	if elemType(field.Type).Kind() != reflect.Struct {
+		return false
+	}
+
+	return e.options.Squash || (!field.IsExported() && name == "")
+}
+
+func (e *mapEncoder) encodeMap(v reflect.Value, path string) (map[string]any, error) {
+	result := make(map[string]any, v.Len())
+
+	iter := v.MapRange()
+	for iter.Next() {
+		key := fmt.Sprint(iter.Key().Interface())
+
+		encoded, err := e.encode(iter.Value(), path+"["+key+"]")
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = encoded
+	}
+
+	return result, nil
+}
+
+func (e *mapEncoder) encodeSlice(v reflect.Value, path string) ([]any, error) {
+	result := make([]any, v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		encoded, err := e.encode(v.Index(i), path+"["+strconv.Itoa(i)+"]")
+		if err != nil {
+			return nil, err
+		}
+
+		result[i] = encoded
+	}
+
+	return result, nil
+}
+
+func parseTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, ",")
+
+	options := make(map[string]bool, len(parts)-1)
+	for _, option := range parts[1:] {
+		options[strings.TrimSpace(option)] = true
+	}
+
+	return parts[0], options
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func hasExportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() || t.Field(i).Anonymous {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,180 @@
+package reflectify
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToMap(t *testing.T) {
+	t.Run("to map should export fields by name", func(t *testing.T) {
+		result, err := Reflect(TestStruct{Field1: "test"}).ToMap()
+
+		expected := map[string]any{"Field1": "test"}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should recurse into nested structs, slices and maps", func(t *testing.T) {
+		order := &testOrder{
+			ID:       5,
+			Customer: testCustomer{Name: "john", Address: testAddress{City: "Zurich"}},
+			Items:    []testItem{{Price: 1.5}},
+		}
+
+		result, err := Reflect(order).ToMapWith(MapOptions{TagName: "json"})
+
+		expected := map[string]any{
+			"TestAudit": nil,
+			"id":        5,
+			"Customer":  map[string]any{"Name": "john", "Address": map[string]any{"City": "Zurich"}},
+			"Items":     []any{map[string]any{"Price": 1.5}},
+		}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should convert map keys and keep opaque structs", func(t *testing.T) {
+		created := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+		value := struct {
+			Limits  map[int]time.Time
+			Payload []byte
+		}{Limits: map[int]time.Time{1: created}, Payload: []byte("test")}
+
+		result, err := Reflect(value).ToMap()
+
+		expected := map[string]any{"Limits": map[string]any{"1": created}, "Payload": []byte("test")}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should honor tag names and omitempty", func(t *testing.T) {
+		value := testExport{Name: "john"}
+
+		result, err := Reflect(value).ToMapWith(MapOptions{TagName: "json"})
+
+		expected := map[string]any{"name": "john", "Created": time.Time{}}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should omit all empty fields on request", func(t *testing.T) {
+		result, err := Reflect(testExport{Name: "john"}).ToMapWith(MapOptions{TagName: "json", OmitEmpty: true})
+
+		expected := map[string]any{"name": "john"}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should squash embedded structs", func(t *testing.T) {
+		value := testProfile{testLocation: testLocation{City: "Zurich"}, FirstName: "john"}
+
+		result, err := Reflect(value).ToMapWith(MapOptions{TagName: "json", Squash: true, OmitEmpty: true})
+
+		expected := map[string]any{"city": "Zurich", "first_name": "john"}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should squash unexported embedded structs by default", func(t *testing.T) {
+		value := testProfile{testLocation: testLocation{City: "Zurich"}, Age: 5}
+
+		result, err := Reflect(value).ToMapWith(MapOptions{TagName: "json", OmitEmpty: true})
+
+		expected := map[string]any{"city": "Zurich", "age": 5}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should keep outer fields over squashed fields", func(t *testing.T) {
+		value := TestDocument{testBase: testBase{ID: 1, Name: "base"}, Name: "document"}
+
+		result, err := Reflect(&value).ToMapWith(MapOptions{Squash: true})
+
+		expected := map[string]any{"ID": 1, "Name": "document"}
+		if err != nil || !reflect.DeepEqual(result, expected) {
+			t.Errorf("map %v given. expected: %v. error: %v", result, expected, err)
+		}
+	})
+
+	t.Run("to map should be the reverse of fill", func(t *testing.T) {
+		value := testProfile{FirstName: "john", Age: 5, Labels: map[string]string{"a": "1"}}
+
+		exported, err := Reflect(value).ToMapWith(MapOptions{TagName: "json", Squash: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		filled, err := Reflect(&testProfile{}).FillWith(exported, FillOptions{TagName: "json", Squash: true})
+		if err != nil || !reflect.DeepEqual(*filled.(*testProfile), value) {
+			t.Errorf("filled %+v does not match %+v. error: %v", filled, value, err)
+		}
+	})
+
+	t.Run("to map should detect cycles", func(t *testing.T) {
+		node := &testNode{Name: "root"}
+		node.Children = []*testNode{{Name: "child", Parent: node}}
+
+		_, err := Reflect(node).ToMap()
+
+		var pathErr *PathError
+		if !errors.Is(err, ErrCycle) || !errors.As(err, &pathErr) || pathErr.Path != "Children[0].Parent" {
+			t.Errorf("expected cycle error at Children[0].Parent. %v given", err)
+		}
+	})
+
+	t.Run("to map should allow shared references", func(t *testing.T) {
+		shared := &testNode{Name: "shared"}
+
+		result, err := Reflect(&testNode{Children: []*testNode{shared, shared}}).ToMap()
+
+		if err != nil || len(result["Children"].([]any)) != 2 {
+			t.Errorf("shared references should be exported twice. %v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("to map of none structs should fail", func(t *testing.T) {
+		_, err := Reflect(5).ToMap()
+
+		if !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("expected type mismatch error. %v given", err)
+		}
+	})
+
+	t.Run("to map of nil struct pointers should be nil", func(t *testing.T) {
+		result, err := Reflect((*testExport)(nil)).ToMap()
+
+		if err != nil || result != nil {
+			t.Errorf("map should be nil. %v given. error: %v", result, err)
+		}
+	})
+
+	t.Run("to map of empty structs should be empty", func(t *testing.T) {
+		result, err := Reflect(TestStruct2{}).ToMap()
+
+		if err != nil || len(result) != 0 {
+			t.Errorf("map should be empty. %v given. error: %v", result, err)
+		}
+	})
+}
+
+type testExport struct {
+	Name     string `json:"name"`
+	Nickname string `json:"nickname,omitempty"`
+	Secret   string `json:"-"`
+	Created  time.Time
+}
+
+type testNode struct {
+	Name     string
+	Parent   *testNode
+	Children []*testNode
+}